@@ -0,0 +1,13 @@
+data "workos_audit_log_actions" "all" {}
+
+# Fail the plan when an action used by alerting rules has no schema.
+check "audit_log_actions_registered" {
+  assert {
+    condition     = contains(data.workos_audit_log_actions.all.names, "user.signed_in")
+    error_message = "The user.signed_in audit log action has no schema in this environment."
+  }
+}
+
+output "audit_log_action_versions" {
+  value = { for action in data.workos_audit_log_actions.all.actions : action.name => action.schema_version }
+}
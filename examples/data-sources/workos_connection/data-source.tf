@@ -18,3 +18,12 @@ data "workos_connection" "by_org_type" {
 output "connection_state" {
   value = data.workos_connection.by_org_type.state
 }
+
+# Values to configure on the identity provider side of a SAML connection
+output "okta_acs_url" {
+  value = data.workos_connection.by_org_type.acs_url
+}
+
+output "okta_sp_entity_id" {
+  value = data.workos_connection.by_org_type.sp_entity_id
+}
@@ -0,0 +1,18 @@
+data "workos_connection" "acme" {
+  organization_id = "org_01HXYZ..."
+  connection_type = "KeycloakSAML"
+}
+
+data "workos_connection_saml_metadata" "acme" {
+  connection_id = data.workos_connection.acme.id
+}
+
+# Hand the document to an identity provider managed in the same configuration
+resource "local_file" "workos_sp_metadata" {
+  filename = "${path.module}/workos-sp-metadata.xml"
+  content  = data.workos_connection_saml_metadata.acme.metadata_xml
+}
+
+output "workos_sp_entity_id" {
+  value = data.workos_connection_saml_metadata.acme.entity_id
+}
@@ -17,3 +17,9 @@ data "workos_directory" "by_org" {
 output "directory_endpoint" {
   value = data.workos_directory.by_org.endpoint
 }
+
+# By Organization and Type, when the organization has several directories
+data "workos_directory" "scim" {
+  organization_id = "org_01HXYZ..."
+  type            = "okta scim v2.0"
+}
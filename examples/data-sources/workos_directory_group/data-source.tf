@@ -18,3 +18,20 @@ data "workos_directory_group" "engineering" {
 output "engineering_group_id" {
   value = data.workos_directory_group.engineering.id
 }
+
+output "engineering_group_attributes" {
+  value = jsondecode(data.workos_directory_group.engineering.raw_attributes)
+}
+
+# By Directory and Identity Provider ID (stable across renames in the IdP)
+data "workos_directory_group" "by_idp_id" {
+  directory_id = "directory_01HXYZ..."
+  idp_id       = "00g1a2b3c4d5e6f7g8h9"
+}
+
+# Wait up to 10 minutes for the group to be synced from a new directory
+data "workos_directory_group" "admins" {
+  directory_id     = "directory_01HXYZ..."
+  name             = "Admins"
+  wait_for_results = "10m"
+}
@@ -0,0 +1,13 @@
+data "workos_directory_user" "jane" {
+  directory_id = data.workos_directory.main.id
+  email        = "jane@example.com"
+}
+
+# Only the groups Jane belongs to are fetched from WorkOS.
+data "workos_directory_groups" "jane" {
+  user_id = data.workos_directory_user.jane.id
+}
+
+output "jane_is_engineer" {
+  value = contains([for group in data.workos_directory_groups.jane.groups : group.name], "Engineering")
+}
@@ -18,3 +18,16 @@ data "workos_directory_user" "john" {
 output "john_full_name" {
   value = "${data.workos_directory_user.john.first_name} ${data.workos_directory_user.john.last_name}"
 }
+
+# By Directory and Identity Provider ID (e.g. the Okta user ID)
+data "workos_directory_user" "by_idp_id" {
+  directory_id = "directory_01HXYZ..."
+  idp_id       = "00u1a2b3c4d5e6f7g8h9"
+}
+
+# Wait up to 10 minutes for the user to be synced from a new directory
+data "workos_directory_user" "admin" {
+  directory_id     = "directory_01HXYZ..."
+  email            = "admin@example.com"
+  wait_for_results = "10m"
+}
@@ -0,0 +1,17 @@
+data "workos_directory" "main" {
+  organization_id = workos_organization.example.id
+}
+
+data "workos_directory_group" "engineering" {
+  directory_id = data.workos_directory.main.id
+  name         = "Engineering"
+}
+
+# Only the members of the Engineering group are fetched from WorkOS.
+data "workos_directory_users" "engineering" {
+  group_id = data.workos_directory_group.engineering.id
+}
+
+output "engineering_emails" {
+  value = [for user in data.workos_directory_users.engineering.users : user.email]
+}
@@ -0,0 +1,16 @@
+# Look up the most recent invitation sent to an email address
+data "workos_invitation" "jane_acme" {
+  email           = "jane@example.com"
+  organization_id = data.workos_organization.acme.id
+}
+
+# Re-send the invitation once it has expired
+resource "workos_invitation_resend" "jane_acme" {
+  count = data.workos_invitation.jane_acme.state == "expired" ? 1 : 0
+
+  invitation_id = data.workos_invitation.jane_acme.id
+}
+
+output "invitation_expires_at" {
+  value = data.workos_invitation.jane_acme.expires_at
+}
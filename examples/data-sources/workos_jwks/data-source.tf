@@ -0,0 +1,18 @@
+data "workos_jwks" "this" {}
+
+# Point a gateway at the key set URL...
+output "workos_jwks_url" {
+  value = data.workos_jwks.this.url
+}
+
+# ...or hand the keys to services that cannot fetch it themselves.
+resource "kubernetes_config_map" "workos_jwks" {
+  metadata {
+    name      = "workos-jwks"
+    namespace = "api"
+  }
+
+  data = {
+    "jwks.json" = data.workos_jwks.this.json
+  }
+}
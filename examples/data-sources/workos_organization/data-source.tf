@@ -24,3 +24,12 @@ data "workos_organization" "by_external_id" {
 output "org_name_by_external_id" {
   value = data.workos_organization.by_external_id.name
 }
+
+# Look up an organization by name (must be unique)
+data "workos_organization" "by_name" {
+  name = "Acme Corporation"
+}
+
+output "org_id_by_name" {
+  value = data.workos_organization.by_name.id
+}
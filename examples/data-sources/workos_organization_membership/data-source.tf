@@ -0,0 +1,9 @@
+# Look up a membership created outside of Terraform
+data "workos_organization_membership" "jane_acme" {
+  user_id         = "user_01HXYZ..."
+  organization_id = "org_01HXYZ..."
+}
+
+output "jane_acme_role" {
+  value = data.workos_organization_membership.jane_acme.role_slug
+}
@@ -0,0 +1,12 @@
+# Generate an Admin Portal link for configuring SSO
+data "workos_portal_setup_link" "sso" {
+  organization_id = workos_organization.acme.id
+  intent          = "sso"
+  return_url      = "https://app.example.com/settings/sso"
+  success_url     = "https://app.example.com/settings/sso?configured=true"
+}
+
+output "acme_sso_setup_link" {
+  value     = data.workos_portal_setup_link.sso.link
+  sensitive = true
+}
@@ -0,0 +1,15 @@
+data "workos_user" "leaver" {
+  email = "jane@example.com"
+}
+
+data "workos_user_memberships" "leaver" {
+  user_id = data.workos_user.leaver.id
+}
+
+# Organizations where the leaver still has active access
+output "leaver_active_organizations" {
+  value = [
+    for membership in data.workos_user_memberships.leaver.memberships :
+    membership.organization_id if membership.status == "active"
+  ]
+}
@@ -0,0 +1,7 @@
+data "workos_user_mfa_factors" "jane" {
+  user_id = workos_user.jane.id
+}
+
+output "jane_has_mfa" {
+  value = data.workos_user_mfa_factors.jane.mfa_enrolled
+}
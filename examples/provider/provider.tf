@@ -11,8 +11,12 @@ terraform {
 #
 # Authentication can be provided via:
 # 1. The api_key attribute below
-# 2. The WORKOS_API_KEY environment variable
+# 2. The api_key_file attribute, pointing at a mounted secret
+# 3. The api_key_command attribute, running a credential helper
+# 4. The WORKOS_API_KEY or WORKOS_API_KEY_FILE environment variables
 #
 provider "workos" {
   # api_key = var.workos_api_key  # Or use WORKOS_API_KEY env var
+  # api_key_file = "/var/run/secrets/workos/api_key"
+  # api_key_command = ["vault", "kv", "get", "-field=api_key", "secret/workos"]
 }
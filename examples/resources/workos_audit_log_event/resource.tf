@@ -0,0 +1,25 @@
+# Record each apply in the organization's audit trail
+resource "workos_audit_log_event" "apply" {
+  organization_id  = workos_organization.acme.id
+  action           = "terraform.apply.completed"
+  actor_id         = "github-actions"
+  actor_type       = "service"
+  actor_name       = "GitHub Actions"
+  context_location = "203.0.113.10"
+
+  targets = [
+    {
+      id   = "workspace-production"
+      type = "terraform_workspace"
+    },
+  ]
+
+  metadata = {
+    commit = var.git_sha
+  }
+
+  # Publish a new event whenever the deployed commit changes
+  triggers = {
+    commit = var.git_sha
+  }
+}
@@ -0,0 +1,20 @@
+# Rotate the secret every quarter by bumping the trigger. The previous secret
+# stays valid until the next rotation, giving clients a quarter to pick up the
+# new one.
+resource "workos_connect_application_client_secret" "billing_worker" {
+  application_id = workos_connect_application.m2m.id
+
+  rotation_triggers = {
+    quarter = "2026-Q4"
+  }
+}
+
+resource "vault_kv_secret_v2" "billing_worker" {
+  mount = "secret"
+  name  = "billing-worker/workos"
+
+  data_json = jsonencode({
+    client_id     = workos_connect_application.m2m.client_id
+    client_secret = workos_connect_application_client_secret.billing_worker.secret
+  })
+}
@@ -0,0 +1,19 @@
+# Invite a user to sign up to the environment
+resource "workos_invitation" "contractor" {
+  email = "contractor@example.com"
+}
+
+# Invite an administrator to an organization, sending a new invitation
+# whenever the previous one expires before it is accepted
+resource "workos_invitation" "acme_admin" {
+  email              = "admin@acme.com"
+  organization_id    = workos_organization.acme.id
+  role_slug          = "admin"
+  expires_in_days    = 14
+  recreate_on_expiry = true
+}
+
+output "acme_admin_invitation_state" {
+  value       = workos_invitation.acme_admin.state
+  description = "Whether the administrator has accepted the invitation"
+}
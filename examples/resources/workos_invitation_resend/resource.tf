@@ -0,0 +1,15 @@
+# Re-send a specific invitation
+resource "workos_invitation_resend" "admin" {
+  invitation_id = "invitation_01HXYZ"
+}
+
+# Re-send the latest pending or expired invitation for a tenant admin;
+# bump the trigger to send it again
+resource "workos_invitation_resend" "acme_admin" {
+  email           = "admin@acme.com"
+  organization_id = workos_organization.acme.id
+
+  triggers = {
+    attempt = "2"
+  }
+}
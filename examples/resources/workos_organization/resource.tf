@@ -4,6 +4,8 @@ resource "workos_organization" "example" {
   external_id = "acme-corp-123"
   domains     = ["acme.com", "acmecorp.com"]
 
+  stripe_customer_id = "cus_NffrFeUfNV2Hib"
+
   metadata = {
     tier   = "enterprise"
     region = "us-east-1"
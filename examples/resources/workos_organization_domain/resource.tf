@@ -11,6 +11,15 @@ resource "workos_organization_domain" "verified" {
   verify          = true
 }
 
+# Publish the verification TXT record in the same apply
+resource "aws_route53_record" "workos_verification" {
+  zone_id = var.route53_zone_id
+  name    = workos_organization_domain.verified.verification_record_name
+  type    = "TXT"
+  ttl     = 300
+  records = [workos_organization_domain.verified.verification_record_value]
+}
+
 output "organization_domain_state" {
   value = workos_organization_domain.example.state
 }
@@ -0,0 +1,21 @@
+resource "workos_organization_domain" "login" {
+  organization_id = workos_organization.example.id
+  domain          = "login.example.com"
+}
+
+# Publish the verification TXT record
+resource "aws_route53_record" "workos_verification" {
+  zone_id = var.route53_zone_id
+  name    = workos_organization_domain.login.verification_record_name
+  type    = "TXT"
+  ttl     = 300
+  records = [workos_organization_domain.login.verification_record_value]
+}
+
+# Wait for WorkOS to verify the domain once the record exists
+resource "workos_organization_domain_verification" "login" {
+  organization_domain_id = workos_organization_domain.login.id
+  timeout                = "30m"
+
+  depends_on = [aws_route53_record.workos_verification]
+}
@@ -4,6 +4,10 @@ resource "workos_organization" "example" {
   domains = ["acme.com"]
 }
 
+resource "workos_organization" "partner" {
+  name = "Partner Inc"
+}
+
 # Create users
 resource "workos_user" "admin" {
   email          = "admin@acme.com"
@@ -46,6 +50,14 @@ resource "workos_organization_membership" "viewer" {
   role_slug       = "viewer"
 }
 
+# Membership that the user must accept by invitation
+resource "workos_organization_membership" "invited" {
+  user_id           = workos_user.viewer.id
+  organization_id   = workos_organization.partner.id
+  role_slug         = "member"
+  provisioning_mode = "invite"
+}
+
 # Outputs
 output "admin_membership_id" {
   value       = workos_organization_membership.admin.id
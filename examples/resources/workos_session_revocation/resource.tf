@@ -0,0 +1,13 @@
+# Sign a departing user out everywhere
+resource "workos_session_revocation" "offboard_jane" {
+  user_id = workos_user.jane.id
+}
+
+# Sign every member out of an organization; bump the trigger to do it again
+resource "workos_session_revocation" "acme_reset" {
+  organization_id = workos_organization.acme.id
+
+  triggers = {
+    reason = "security-incident-2026-10"
+  }
+}
@@ -52,6 +52,39 @@ resource "workos_user" "migrated" {
   email_verified     = true
 }
 
+# User whose email changes are confirmed by the owner of the new address
+resource "workos_user" "verified_changes" {
+  email               = "tenant-admin@example.com"
+  first_name          = "Tenant"
+  last_name           = "Admin"
+  verify_email_change = true
+}
+
+# User whose personal data is scrubbed before deletion on destroy
+resource "workos_user" "gdpr_scrubbed" {
+  email             = "customer@example.com"
+  first_name        = "Erin"
+  last_name         = "Example"
+  deletion_behavior = "anonymize"
+}
+
+# User created straight into an organization with a role
+resource "workos_organization" "example" {
+  name = "Acme Corporation"
+}
+
+resource "workos_user" "tenant_admin" {
+  email          = "tenant-owner@example.com"
+  first_name     = "Tenant"
+  last_name      = "Owner"
+  email_verified = true
+
+  organization_membership {
+    organization_id = workos_organization.example.id
+    role_slug       = "admin"
+  }
+}
+
 # Variables
 variable "user_password" {
   type        = string
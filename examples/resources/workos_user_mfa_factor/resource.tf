@@ -0,0 +1,12 @@
+# Enroll a TOTP factor for a user
+resource "workos_user_mfa_factor" "jane_totp" {
+  user_id     = workos_user.jane.id
+  totp_issuer = "Acme"
+  totp_user   = workos_user.jane.email
+}
+
+# The secret and QR code are only returned at enrollment
+output "jane_totp_qr_code" {
+  value     = workos_user_mfa_factor.jane_totp.totp_qr_code
+  sensitive = true
+}
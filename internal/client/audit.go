@@ -0,0 +1,116 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/user"
+	"sync"
+	"time"
+)
+
+// AuditRecord is the JSON line written to the audit log for every create,
+// update or delete request sent to the API.
+type AuditRecord struct {
+	Timestamp  time.Time `json:"timestamp"`
+	Method     string    `json:"method"`
+	Resource   string    `json:"resource"`
+	StatusCode int       `json:"status_code,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+	Caller     string    `json:"caller"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// auditLog serialises records written by every client sharing a destination.
+type auditLog struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+var (
+	auditLogsMu sync.Mutex
+	// auditLogs holds the audit logs opened by the process, keyed by path, so
+	// provider configurations that share a path share one file handle.
+	auditLogs = map[string]*auditLog{}
+)
+
+// SetAuditLog makes the client append a record of every mutating API request
+// to the file at path. caller identifies who made the changes; it defaults to
+// the operating system user and host the provider runs as.
+//
+// Standard output is not an option: it carries the plugin protocol between
+// Terraform and the provider, so path must name a file.
+func (c *Client) SetAuditLog(path, caller string) error {
+	if path == "-" {
+		return fmt.Errorf("audit log path must be a file; standard output is reserved for communication with Terraform")
+	}
+
+	auditLogsMu.Lock()
+	defer auditLogsMu.Unlock()
+
+	log, ok := auditLogs[path]
+	if !ok {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to open audit log: %w", err)
+		}
+		log = &auditLog{w: f}
+		auditLogs[path] = log
+	}
+
+	if caller == "" {
+		caller = defaultAuditCaller()
+	}
+	c.auditLog = log
+	c.auditCaller = caller
+	return nil
+}
+
+// defaultAuditCaller returns user@host for the process.
+func defaultAuditCaller() string {
+	caller := "unknown"
+	if u, err := user.Current(); err == nil {
+		caller = u.Username
+	}
+	if host, err := os.Hostname(); err == nil {
+		caller += "@" + host
+	}
+	return caller
+}
+
+// recordAudit appends a record of a mutating request to the audit log, if one
+// is set. Requests are recorded once, after any retries, whether they
+// succeeded or not.
+func (c *Client) recordAudit(method, path string, resp *http.Response, err error) {
+	if c.auditLog == nil || method == http.MethodGet {
+		return
+	}
+
+	record := AuditRecord{
+		Timestamp: time.Now().UTC(),
+		Method:    method,
+		Resource:  operationPath(path),
+		Caller:    c.auditCaller,
+	}
+	if resp != nil {
+		record.StatusCode = resp.StatusCode
+		record.RequestID = resp.Header.Get("X-Request-ID")
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	line, marshalErr := json.Marshal(record)
+	if marshalErr != nil {
+		return
+	}
+
+	c.auditLog.mu.Lock()
+	defer c.auditLog.mu.Unlock()
+	_, _ = c.auditLog.w.Write(append(line, '\n'))
+}
@@ -0,0 +1,108 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// AuditLogEvent represents an event published to the Audit Logs API.
+type AuditLogEvent struct {
+	Action     string            `json:"action"`
+	OccurredAt time.Time         `json:"occurred_at"`
+	Version    int64             `json:"version,omitempty"`
+	Actor      AuditLogActor     `json:"actor"`
+	Targets    []AuditLogTarget  `json:"targets"`
+	Context    AuditLogContext   `json:"context"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+// AuditLogActor represents the actor that performed an audit log event.
+type AuditLogActor struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+// AuditLogTarget represents an object affected by an audit log event.
+type AuditLogTarget struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+// AuditLogContext describes where an audit log event originated.
+type AuditLogContext struct {
+	Location  string `json:"location"`
+	UserAgent string `json:"user_agent,omitempty"`
+}
+
+// AuditLogEventCreateRequest represents the request to publish an audit log event.
+type AuditLogEventCreateRequest struct {
+	OrganizationID string        `json:"organization_id"`
+	Event          AuditLogEvent `json:"event"`
+}
+
+// CreateAuditLogEvent publishes an audit log event for an organization.
+func (c *Client) CreateAuditLogEvent(ctx context.Context, req *AuditLogEventCreateRequest) error {
+	err := c.Post(ctx, "/audit_logs/events", req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create audit log event: %w", err)
+	}
+	return nil
+}
+
+// AuditLogAction represents an audit log action registered in the environment.
+type AuditLogAction struct {
+	Object    string         `json:"object"`
+	Name      string         `json:"name"`
+	Schema    AuditLogSchema `json:"schema"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// AuditLogSchema represents the latest schema version of an audit log action.
+type AuditLogSchema struct {
+	Object  string                 `json:"object"`
+	Version int64                  `json:"version"`
+	Targets []AuditLogSchemaTarget `json:"targets"`
+}
+
+// AuditLogSchemaTarget describes a target type accepted by an audit log schema.
+type AuditLogSchemaTarget struct {
+	Type string `json:"type"`
+}
+
+// AuditLogActionListResponse represents the response from listing audit log actions.
+type AuditLogActionListResponse struct {
+	Data         []AuditLogAction `json:"data"`
+	ListMetadata ListMetadata     `json:"list_metadata"`
+}
+
+// ListAuditLogActions lists the audit log actions registered in the environment.
+func (c *Client) ListAuditLogActions(ctx context.Context) (*AuditLogActionListResponse, error) {
+	var all AuditLogActionListResponse
+	params := url.Values{}
+	applyDefaultPagination(params)
+
+	for {
+		var page AuditLogActionListResponse
+		err := c.Get(ctx, pathWithQuery("/audit_logs/actions", params), &page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list audit log actions: %w", err)
+		}
+
+		all.Data = append(all.Data, page.Data...)
+		all.ListMetadata = page.ListMetadata
+		if page.ListMetadata.After == "" {
+			break
+		}
+		params.Set("after", page.ListMetadata.After)
+	}
+
+	return &all, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAuditLogsClientCreateEvent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/audit_logs/events" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		var body AuditLogEventCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if body.OrganizationID != "org_123" || body.Event.Action != "terraform.apply.completed" {
+			t.Fatalf("unexpected request body: %#v", body)
+		}
+		if len(body.Event.Targets) != 1 || body.Event.Context.Location != "10.0.0.1" {
+			t.Fatalf("unexpected event: %#v", body.Event)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	err = client.CreateAuditLogEvent(context.Background(), &AuditLogEventCreateRequest{
+		OrganizationID: "org_123",
+		Event: AuditLogEvent{
+			Action:     "terraform.apply.completed",
+			OccurredAt: time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+			Actor:      AuditLogActor{ID: "ci", Type: "service"},
+			Targets:    []AuditLogTarget{{ID: "workspace_prod", Type: "workspace"}},
+			Context:    AuditLogContext{Location: "10.0.0.1"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateAuditLogEvent returned error: %v", err)
+	}
+}
+
+func TestAuditLogsClientListActionsPaginates(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != http.MethodGet || r.URL.Path != "/audit_logs/actions" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("after") == "" {
+			_, _ = w.Write([]byte(`{"data":[{"object":"audit_log_action","name":"user.signed_in","schema":{"object":"audit_log_schema","version":2,"targets":[{"type":"user"}]}}],"list_metadata":{"after":"user.signed_in"}}`))
+			return
+		}
+		if got := r.URL.Query().Get("after"); got != "user.signed_in" {
+			t.Fatalf("expected after=user.signed_in, got %q", got)
+		}
+		_, _ = w.Write([]byte(`{"data":[{"object":"audit_log_action","name":"team.deleted","schema":{"object":"audit_log_schema","version":1,"targets":[{"type":"team"}]}}],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	actions, err := client.ListAuditLogActions(context.Background())
+	if err != nil {
+		t.Fatalf("ListAuditLogActions returned error: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if len(actions.Data) != 2 || actions.Data[0].Schema.Version != 2 || actions.Data[1].Schema.Targets[0].Type != "team" {
+		t.Fatalf("unexpected actions: %#v", actions.Data)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestClientAuditLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-ID", "req_"+strings.ToLower(r.Method))
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	auditLogPath := filepath.Join(t.TempDir(), "audit.jsonl")
+	if err := c.SetAuditLog(auditLogPath, "ci:1234"); err != nil {
+		t.Fatalf("failed to set audit log: %v", err)
+	}
+
+	ctx := context.Background()
+	_ = c.Post(ctx, "/organizations", map[string]string{"name": "Acme"}, nil)
+	_ = c.Get(ctx, "/organizations/org_123", nil)
+	_ = c.Delete(ctx, "/organizations/org_123?force=true")
+
+	content, err := os.ReadFile(auditLogPath)
+	if err != nil {
+		t.Fatalf("failed to read audit log: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 audit records, got %d: %s", len(lines), content)
+	}
+
+	expected := []AuditRecord{
+		{Method: "POST", Resource: "/organizations", StatusCode: 200, RequestID: "req_post", Caller: "ci:1234"},
+		{Method: "DELETE", Resource: "/organizations/org_123", StatusCode: 404, RequestID: "req_delete", Caller: "ci:1234"},
+	}
+	for i, line := range lines {
+		var record AuditRecord
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("failed to decode audit record %q: %v", line, err)
+		}
+		if record.Timestamp.IsZero() {
+			t.Errorf("expected audit record %d to have a timestamp", i)
+		}
+		record.Timestamp = expected[i].Timestamp
+		if record != expected[i] {
+			t.Errorf("expected audit record %#v, got %#v", expected[i], record)
+		}
+	}
+}
+
+func TestClientAuditLogRejectsStandardOutput(t *testing.T) {
+	c, err := NewClient("sk_test", "", "http://localhost")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	if err := c.SetAuditLog("-", ""); err == nil {
+		t.Fatal("expected an error for an audit log on standard output")
+	}
+	if c.auditLog != nil {
+		t.Fatal("expected no audit log to be set")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// AuthenticationFactor represents a multi-factor authentication factor enrolled for a user.
+type AuthenticationFactor struct {
+	ID        string      `json:"id"`
+	Object    string      `json:"object"`
+	Type      string      `json:"type"`
+	UserID    string      `json:"user_id,omitempty"`
+	TOTP      *TOTPFactor `json:"totp,omitempty"`
+	SMS       *SMSFactor  `json:"sms,omitempty"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// TOTPFactor holds the TOTP details of an authentication factor. Secret, QRCode
+// and URI are only returned when the factor is enrolled.
+type TOTPFactor struct {
+	Issuer string `json:"issuer"`
+	User   string `json:"user"`
+	Secret string `json:"secret,omitempty"`
+	QRCode string `json:"qr_code,omitempty"`
+	URI    string `json:"uri,omitempty"`
+}
+
+// SMSFactor holds the SMS details of an authentication factor.
+type SMSFactor struct {
+	PhoneNumber string `json:"phone_number"`
+}
+
+// AuthenticationFactorEnrollRequest represents the request to enroll an authentication factor for a user.
+type AuthenticationFactorEnrollRequest struct {
+	Type       string `json:"type"`
+	TOTPIssuer string `json:"totp_issuer,omitempty"`
+	TOTPUser   string `json:"totp_user,omitempty"`
+}
+
+// AuthenticationFactorEnrollResponse represents the response from enrolling an authentication factor.
+type AuthenticationFactorEnrollResponse struct {
+	AuthenticationFactor AuthenticationFactor `json:"authentication_factor"`
+}
+
+// AuthenticationFactorListResponse represents the response from listing a user's authentication factors.
+type AuthenticationFactorListResponse struct {
+	Data         []AuthenticationFactor `json:"data"`
+	ListMetadata ListMetadata           `json:"list_metadata"`
+}
+
+// EnrollAuthenticationFactor enrolls an authentication factor for a user.
+func (c *Client) EnrollAuthenticationFactor(ctx context.Context, userID string, req *AuthenticationFactorEnrollRequest) (*AuthenticationFactor, error) {
+	var resp AuthenticationFactorEnrollResponse
+	err := c.Post(ctx, fmt.Sprintf("/user_management/users/%s/auth_factors", url.PathEscape(userID)), req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enroll authentication factor: %w", err)
+	}
+	return &resp.AuthenticationFactor, nil
+}
+
+// GetAuthenticationFactor retrieves an authentication factor by ID.
+func (c *Client) GetAuthenticationFactor(ctx context.Context, id string) (*AuthenticationFactor, error) {
+	var factor AuthenticationFactor
+	err := c.Get(ctx, fmt.Sprintf("/auth/factors/%s", url.PathEscape(id)), &factor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authentication factor: %w", err)
+	}
+	return &factor, nil
+}
+
+// DeleteAuthenticationFactor deletes an authentication factor.
+func (c *Client) DeleteAuthenticationFactor(ctx context.Context, id string) error {
+	err := c.Delete(ctx, fmt.Sprintf("/auth/factors/%s", url.PathEscape(id)))
+	if err != nil {
+		return fmt.Errorf("failed to delete authentication factor: %w", err)
+	}
+	return nil
+}
+
+// ListAuthenticationFactors lists all authentication factors enrolled for a user.
+func (c *Client) ListAuthenticationFactors(ctx context.Context, userID string) (*AuthenticationFactorListResponse, error) {
+	var all AuthenticationFactorListResponse
+	params := url.Values{}
+	applyDefaultPagination(params)
+	path := fmt.Sprintf("/user_management/users/%s/auth_factors", url.PathEscape(userID))
+
+	for {
+		var page AuthenticationFactorListResponse
+		err := c.Get(ctx, pathWithQuery(path, params), &page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list authentication factors: %w", err)
+		}
+
+		all.Data = append(all.Data, page.Data...)
+		all.ListMetadata = page.ListMetadata
+		if page.ListMetadata.After == "" {
+			break
+		}
+		params.Set("after", page.ListMetadata.After)
+	}
+
+	return &all, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAuthFactorsClientEnroll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/user_management/users/user_123/auth_factors" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		var body AuthenticationFactorEnrollRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if body.Type != "totp" || body.TOTPIssuer != "Acme" || body.TOTPUser != "jane@example.com" {
+			t.Fatalf("unexpected request body: %#v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+  "authentication_factor": {
+    "object": "authentication_factor",
+    "id": "auth_factor_123",
+    "type": "totp",
+    "user_id": "user_123",
+    "totp": {
+      "issuer": "Acme",
+      "user": "jane@example.com",
+      "secret": "JJWBYBLLH5TUIMT2",
+      "qr_code": "data:image/png;base64,iVBORw0KGgo=",
+      "uri": "otpauth://totp/Acme:jane%40example.com?secret=JJWBYBLLH5TUIMT2&issuer=Acme"
+    },
+    "created_at": "2026-01-15T12:00:00.000Z",
+    "updated_at": "2026-01-15T12:00:00.000Z"
+  },
+  "authentication_challenge": {"id": "auth_challenge_123"}
+}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	factor, err := client.EnrollAuthenticationFactor(context.Background(), "user_123", &AuthenticationFactorEnrollRequest{
+		Type:       "totp",
+		TOTPIssuer: "Acme",
+		TOTPUser:   "jane@example.com",
+	})
+	if err != nil {
+		t.Fatalf("EnrollAuthenticationFactor returned error: %v", err)
+	}
+	if factor.ID != "auth_factor_123" || factor.TOTP == nil || factor.TOTP.Secret != "JJWBYBLLH5TUIMT2" {
+		t.Fatalf("unexpected factor: %#v", factor)
+	}
+}
+
+func TestAuthFactorsClientListPaginates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/user_management/users/user_123/auth_factors" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("after") == "" {
+			_, _ = w.Write([]byte(`{"data":[{"id":"auth_factor_1","type":"totp"}],"list_metadata":{"after":"auth_factor_1"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"auth_factor_2","type":"totp"}],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	factors, err := client.ListAuthenticationFactors(context.Background(), "user_123")
+	if err != nil {
+		t.Fatalf("ListAuthenticationFactors returned error: %v", err)
+	}
+	if len(factors.Data) != 2 || factors.Data[1].ID != "auth_factor_2" {
+		t.Fatalf("unexpected factors: %#v", factors.Data)
+	}
+}
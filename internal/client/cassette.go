@@ -0,0 +1,266 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CassetteRedacted replaces the values of secret fields in recorded traffic.
+const CassetteRedacted = "REDACTED"
+
+// cassetteSecretFields are the JSON fields whose values are never written to
+// a cassette. Request headers, and with them the API key, are not recorded
+// at all.
+var cassetteSecretFields = map[string]bool{
+	"accept_invitation_url": true,
+	"bearer_token":          true,
+	"client_secret":         true,
+	"password":              true,
+	"password_hash":         true,
+	"secret":                true,
+	"token":                 true,
+}
+
+// cassetteSecretObjects are the JSON objects whose string fields are all
+// redacted, such as a TOTP factor's secret, QR code and URI.
+var cassetteSecretObjects = map[string]bool{
+	"totp": true,
+}
+
+// CassetteInteraction is a recorded API request and its response.
+type CassetteInteraction struct {
+	Method          string            `json:"method"`
+	Path            string            `json:"path"`
+	RequestBody     string            `json:"request_body,omitempty"`
+	StatusCode      int               `json:"status_code"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    string            `json:"response_body,omitempty"`
+}
+
+// Cassette records API traffic so it can be replayed without a live WorkOS
+// environment. The transports of a cassette created with NewCassetteRecorder
+// forward requests and record them; those of one loaded with LoadCassette
+// answer requests from its recording. Requests are matched on method, path, query and body, each
+// recorded interaction being used once, so concurrent requests may be
+// replayed in a different order than they were recorded.
+type Cassette struct {
+	// Values are test inputs, such as generated names, that must be the same
+	// when the recording is replayed.
+	Values []string `json:"values,omitempty"`
+
+	Interactions []CassetteInteraction `json:"interactions"`
+
+	mu        sync.Mutex
+	recording bool
+	used      []bool
+	values    int
+}
+
+// NewCassetteRecorder returns an empty cassette that records live traffic.
+func NewCassetteRecorder() *Cassette {
+	return &Cassette{recording: true}
+}
+
+// LoadCassette reads a recorded cassette for replay.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+	}
+	cassette.used = make([]bool, len(cassette.Interactions))
+	return &cassette, nil
+}
+
+// Recording reports whether the cassette records live traffic.
+func (c *Cassette) Recording() bool {
+	return c.recording
+}
+
+// Transport returns a transport that records requests sent through next, or
+// replays them without using next. The transports of a cassette share its
+// recording, so every client configured during a test can use one.
+func (c *Cassette) Transport(next http.RoundTripper) http.RoundTripper {
+	return &cassetteTransport{cassette: c, next: next}
+}
+
+type cassetteTransport struct {
+	cassette *Cassette
+	next     http.RoundTripper
+}
+
+// Value returns the recorded test input at the next position when replaying,
+// and records and returns generate() when recording.
+func (c *Cassette) Value(generate func() string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Recording() {
+		value := generate()
+		c.Values = append(c.Values, value)
+		return value
+	}
+
+	if c.values >= len(c.Values) {
+		// The test asked for more values than were recorded, so its
+		// requests will not match the recording either.
+		return generate()
+	}
+	value := c.Values[c.values]
+	c.values++
+	return value
+}
+
+// Save writes the recorded traffic to path with secret fields redacted.
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// RoundTrip records or replays a request.
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		requestBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	interaction := CassetteInteraction{
+		Method:      req.Method,
+		Path:        req.URL.RequestURI(),
+		RequestBody: scrubCassetteBody(requestBody),
+	}
+
+	if t.cassette.Recording() {
+		return t.cassette.record(t.next, req, interaction)
+	}
+	return t.cassette.replay(req, interaction)
+}
+
+func (c *Cassette) record(next http.RoundTripper, req *http.Request, interaction CassetteInteraction) (*http.Response, error) {
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction.StatusCode = resp.StatusCode
+	interaction.ResponseBody = scrubCassetteBody(responseBody)
+	for _, header := range []string{"Content-Type", "Retry-After"} {
+		if value := resp.Header.Get(header); value != "" {
+			if interaction.ResponseHeaders == nil {
+				interaction.ResponseHeaders = map[string]string{}
+			}
+			interaction.ResponseHeaders[header] = value
+		}
+	}
+
+	c.mu.Lock()
+	c.Interactions = append(c.Interactions, interaction)
+	c.mu.Unlock()
+
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request, interaction CassetteInteraction) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, recorded := range c.Interactions {
+		if c.used[i] || recorded.Method != interaction.Method || recorded.Path != interaction.Path || recorded.RequestBody != interaction.RequestBody {
+			continue
+		}
+		c.used[i] = true
+
+		header := http.Header{}
+		for name, value := range recorded.ResponseHeaders {
+			header.Set(name, value)
+		}
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode: recorded.StatusCode,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     header,
+			Body:       io.NopCloser(bytes.NewReader([]byte(recorded.ResponseBody))),
+			Request:    req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction left for %s %s; re-record the cassette", interaction.Method, interaction.Path)
+}
+
+// scrubCassetteBody redacts the values of secret fields in a JSON body.
+// Bodies that are not JSON are recorded as they are.
+func scrubCassetteBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	scrubbed, err := json.Marshal(scrubCassetteValue(value, false))
+	if err != nil {
+		return string(body)
+	}
+	return string(scrubbed)
+}
+
+func scrubCassetteValue(value any, redactAll bool) any {
+	switch v := value.(type) {
+	case string:
+		if redactAll {
+			return CassetteRedacted
+		}
+	case map[string]any:
+		for key, field := range v {
+			if _, ok := field.(string); ok && cassetteSecretFields[key] {
+				v[key] = CassetteRedacted
+				continue
+			}
+			v[key] = scrubCassetteValue(field, redactAll || cassetteSecretObjects[key])
+		}
+	case []any:
+		for i := range v {
+			v[i] = scrubCassetteValue(v[i], redactAll)
+		}
+	}
+	return value
+}
@@ -0,0 +1,100 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/organizations/org_123":
+			_, _ = w.Write([]byte(`{"id":"org_123","name":"Acme"}`))
+		case "/user_management/invitations":
+			_, _ = w.Write([]byte(`{"id":"invitation_123","email":"jane@example.com","token":"invitation-token","accept_invitation_url":"https://example.com/accept?token=invitation-token"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder := NewCassetteRecorder()
+	recording, err := NewClient("sk_test_live_key", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	recording.WrapTransport(recorder.Transport)
+
+	name := recorder.Value(func() string { return "tfacc-recorded" })
+	if _, err := recording.GetOrganization(context.Background(), "org_123"); err != nil {
+		t.Fatalf("GetOrganization returned error: %v", err)
+	}
+	if _, err := recording.SendInvitation(context.Background(), &InvitationCreateRequest{Email: "jane@example.com"}); err != nil {
+		t.Fatalf("SendInvitation returned error: %v", err)
+	}
+	if _, err := recording.GetOrganization(context.Background(), "org_missing"); !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if err := recorder.Save(path); err != nil {
+		t.Fatalf("failed to save cassette: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read cassette: %v", err)
+	}
+	for _, secret := range []string{"sk_test_live_key", "invitation-token", server.URL} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+
+	server.Close()
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("failed to load cassette: %v", err)
+	}
+	replaying, err := NewClient("sk_test_replay", "", "https://replay.invalid")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	replaying.WrapTransport(cassette.Transport)
+
+	if got := cassette.Value(func() string { return "tfacc-generated" }); got != name {
+		t.Fatalf("expected the recorded value %q, got %q", name, got)
+	}
+	if _, err := replaying.GetOrganization(context.Background(), "org_missing"); !IsNotFound(err) {
+		t.Fatalf("expected the recorded not found error, got %v", err)
+	}
+	organization, err := replaying.GetOrganization(context.Background(), "org_123")
+	if err != nil {
+		t.Fatalf("GetOrganization returned error: %v", err)
+	}
+	if organization.Name != "Acme" {
+		t.Fatalf("expected the recorded organization, got %#v", organization)
+	}
+	invitation, err := replaying.SendInvitation(context.Background(), &InvitationCreateRequest{Email: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("SendInvitation returned error: %v", err)
+	}
+	if invitation.Token != CassetteRedacted {
+		t.Fatalf("expected the token to be redacted, got %q", invitation.Token)
+	}
+
+	if _, err := replaying.GetOrganization(context.Background(), "org_123"); err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+		t.Fatalf("expected an error once the recording is used up, got %v", err)
+	}
+}
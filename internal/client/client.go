@@ -14,6 +14,8 @@ import (
 	"net/http"
 	"strconv"
 	"time"
+
+	"github.com/hashicorp/go-hclog"
 )
 
 const (
@@ -23,7 +25,8 @@ const (
 	// DefaultTimeout is the default HTTP client timeout
 	DefaultTimeout = 30 * time.Second
 
-	// MaxRetries is the maximum number of retry attempts for rate-limited requests
+	// MaxRetries is the maximum number of retry attempts for rate-limited or
+	// maintenance responses
 	MaxRetries = 3
 
 	// BaseRetryDelay is the base delay for exponential backoff
@@ -31,6 +34,14 @@ const (
 
 	// MaxRetryDelay is the maximum delay between retries
 	MaxRetryDelay = 30 * time.Second
+
+	// DefaultMaxIdleConnsPerHost is the number of keep-alive connections kept
+	// open to the API. It matches Terraform's default parallelism of 10.
+	DefaultMaxIdleConnsPerHost = 10
+
+	// MaxMaintenanceDelay caps how long a single Retry-After on a 503
+	// maintenance response is honored
+	MaxMaintenanceDelay = 5 * time.Minute
 )
 
 // Client is the WorkOS API client
@@ -43,6 +54,30 @@ type Client struct {
 	// Organization role mutations update a priority list shared by every role
 	// in the organization, so concurrent requests must be coordinated.
 	organizationRoleMutations keyedLock
+
+	metrics            metricsCounter
+	pendingMaintenance maintenanceDelay
+
+	// snapshots is set when batch reads are enabled.
+	snapshots *readSnapshots
+
+	removeOnForbidden bool
+
+	// defaultMetadata is merged into the metadata of users and organizations
+	// the provider creates or updates.
+	defaultMetadata map[string]string
+
+	// validateReferences is set when resources check at plan time that the
+	// organizations and users they reference exist.
+	validateReferences bool
+
+	// logLevel is the level of the client's log subsystem. hclog.NoLevel
+	// inherits the provider's level.
+	logLevel hclog.Level
+
+	// auditLog is set when mutating requests are recorded to an audit log.
+	auditLog    *auditLog
+	auditCaller string
 }
 
 // NewClient creates a new WorkOS API client
@@ -57,7 +92,8 @@ func NewClient(apiKey, clientID, baseURL string) (*Client, error) {
 
 	return &Client{
 		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
+			Timeout:   DefaultTimeout,
+			Transport: newTransport(DefaultMaxIdleConnsPerHost),
 		},
 		apiKey:   apiKey,
 		clientID: clientID,
@@ -65,8 +101,79 @@ func NewClient(apiKey, clientID, baseURL string) (*Client, error) {
 	}, nil
 }
 
-// doRequest performs an HTTP request with automatic retry on rate limiting
-func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+// newTransport returns an HTTP transport that keeps enough idle connections to
+// the API for concurrent Terraform operations to reuse them instead of paying
+// for a new TCP and TLS handshake per request. Responses are requested
+// gzip-compressed and decompressed transparently, and HTTP/2 is negotiated
+// when the server supports it.
+func newTransport(maxIdleConnsPerHost int) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	if transport.MaxIdleConns < maxIdleConnsPerHost {
+		transport.MaxIdleConns = maxIdleConnsPerHost
+	}
+	transport.ForceAttemptHTTP2 = true
+	transport.DisableCompression = false
+	return transport
+}
+
+// ClientID returns the WorkOS client ID the provider was configured with, if
+// any.
+func (c *Client) ClientID() string {
+	return c.clientID
+}
+
+// SetMaxIdleConnsPerHost sets how many keep-alive connections to the API are
+// kept open between requests.
+func (c *Client) SetMaxIdleConnsPerHost(n int) {
+	c.httpClient.Transport = newTransport(n)
+}
+
+// WrapTransport wraps the HTTP transport, for example to record or replay API
+// traffic in acceptance tests.
+func (c *Client) WrapTransport(wrap func(http.RoundTripper) http.RoundTripper) {
+	c.httpClient.Transport = wrap(c.httpClient.Transport)
+}
+
+// SetRemoveOnForbidden controls whether resources treat a 403 returned while
+// reading them as the object being gone.
+func (c *Client) SetRemoveOnForbidden(remove bool) {
+	c.removeOnForbidden = remove
+}
+
+// RemoveOnForbidden reports whether resources treat a 403 returned while
+// reading them as the object being gone.
+func (c *Client) RemoveOnForbidden() bool {
+	return c.removeOnForbidden
+}
+
+// SetDefaultMetadata sets the metadata merged into every user and
+// organization managed by the provider.
+func (c *Client) SetDefaultMetadata(metadata map[string]string) {
+	c.defaultMetadata = metadata
+}
+
+// DefaultMetadata returns the metadata merged into every user and organization
+// managed by the provider.
+func (c *Client) DefaultMetadata() map[string]string {
+	return c.defaultMetadata
+}
+
+// SetValidateReferences controls whether resources check at plan time that
+// the organizations and users they reference exist.
+func (c *Client) SetValidateReferences(validate bool) {
+	c.validateReferences = validate
+}
+
+// ValidateReferences reports whether resources check at plan time that the
+// organizations and users they reference exist.
+func (c *Client) ValidateReferences() bool {
+	return c.validateReferences
+}
+
+// doRequest performs an HTTP request with automatic retry on rate limiting and
+// on 503 maintenance responses that carry a Retry-After header
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (resp *http.Response, err error) {
 	var bodyReader io.Reader
 
 	if body != nil {
@@ -77,6 +184,14 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		bodyReader = bytes.NewReader(jsonBody)
 	}
 
+	if method != http.MethodGet {
+		c.resetSnapshots()
+	}
+
+	ctx = c.logContext(ctx, method, path)
+	defer func() { c.recordAudit(method, path, resp, err) }()
+	defer func() { logUsage(ctx, c.Metrics()) }()
+
 	for attempt := 0; attempt <= MaxRetries; attempt++ {
 		// Reset body reader for retries
 		if body != nil {
@@ -94,19 +209,36 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("User-Agent", "terraform-provider-workos")
 
+		c.recordRequest(attempt)
+		logRequest(ctx, attempt)
+		start := time.Now()
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
+			logRequestError(ctx, attempt, err)
 			return nil, fmt.Errorf("request failed: %w", err)
 		}
-
-		// Handle rate limiting (429)
-		if resp.StatusCode == http.StatusTooManyRequests {
+		logResponse(ctx, attempt, resp, time.Since(start))
+
+		// Handle rate limiting (429) and planned maintenance (503 with Retry-After)
+		rateLimited := resp.StatusCode == http.StatusTooManyRequests
+		maintenance := resp.StatusCode == http.StatusServiceUnavailable && resp.Header.Get("Retry-After") != ""
+		if rateLimited || maintenance {
+			if rateLimited {
+				c.recordRateLimited()
+			}
 			if attempt == MaxRetries {
-				return resp, nil // Return the 429 response on final attempt
+				return resp, nil // Return the response on final attempt
 			}
 
 			// Calculate retry delay
 			delay := c.calculateRetryDelay(resp, attempt)
+			if maintenance {
+				if delay > MaxMaintenanceDelay {
+					delay = MaxMaintenanceDelay
+				}
+				c.recordMaintenanceWait(delay)
+			}
+			logRetry(ctx, attempt, resp.StatusCode, delay)
 
 			// Close the response body before retrying
 			resp.Body.Close()
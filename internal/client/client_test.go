@@ -0,0 +1,44 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientTransportReusesConnections(t *testing.T) {
+	connections := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connections[r.RemoteAddr] = true
+		if got := r.Header.Get("Accept-Encoding"); got != "gzip" {
+			t.Fatalf("expected Accept-Encoding gzip, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"org_123","name":"Acme"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.SetMaxIdleConnsPerHost(4)
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	if !ok || transport.MaxIdleConnsPerHost != 4 {
+		t.Fatalf("expected a transport keeping 4 idle connections, got %#v", client.httpClient.Transport)
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, err := client.GetOrganization(context.Background(), "org_123"); err != nil {
+			t.Fatalf("GetOrganization returned error: %v", err)
+		}
+	}
+	if len(connections) != 1 {
+		t.Fatalf("expected sequential requests to share one connection, got %d", len(connections))
+	}
+}
@@ -128,3 +128,50 @@ func (c *Client) ListConnectApplications(ctx context.Context, organizationID str
 
 	return &all, nil
 }
+
+// ConnectApplicationSecret represents a client secret of a Connect application.
+// Secret is only returned when the secret is created.
+type ConnectApplicationSecret struct {
+	ID         string     `json:"id"`
+	Object     string     `json:"object"`
+	Secret     string     `json:"secret,omitempty"`
+	SecretHint string     `json:"secret_hint"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// ConnectApplicationSecretListResponse represents the response from listing
+// the client secrets of a Connect application.
+type ConnectApplicationSecretListResponse struct {
+	Data []ConnectApplicationSecret `json:"data"`
+}
+
+// CreateConnectApplicationSecret creates a client secret for a Connect application.
+func (c *Client) CreateConnectApplicationSecret(ctx context.Context, applicationID string) (*ConnectApplicationSecret, error) {
+	var secret ConnectApplicationSecret
+	err := c.Post(ctx, "/connect/applications/"+url.PathEscape(applicationID)+"/client_secrets", struct{}{}, &secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connect application secret: %w", err)
+	}
+	return &secret, nil
+}
+
+// ListConnectApplicationSecrets lists the client secrets of a Connect application.
+func (c *Client) ListConnectApplicationSecrets(ctx context.Context, applicationID string) (*ConnectApplicationSecretListResponse, error) {
+	var resp ConnectApplicationSecretListResponse
+	err := c.Get(ctx, "/connect/applications/"+url.PathEscape(applicationID)+"/client_secrets", &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connect application secrets: %w", err)
+	}
+	return &resp, nil
+}
+
+// DeleteConnectApplicationSecret deletes a Connect application client secret.
+func (c *Client) DeleteConnectApplicationSecret(ctx context.Context, id string) error {
+	err := c.Delete(ctx, "/connect/client_secrets/"+url.PathEscape(id))
+	if err != nil {
+		return fmt.Errorf("failed to delete connect application secret: %w", err)
+	}
+	return nil
+}
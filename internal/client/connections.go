@@ -5,7 +5,10 @@ package client
 
 import (
 	"context"
+	"encoding/xml"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 )
 
@@ -17,6 +20,12 @@ type ConnectionListResponse struct {
 
 // GetConnection retrieves a connection by ID
 func (c *Client) GetConnection(ctx context.Context, id string) (*Connection, error) {
+	if c.snapshots != nil {
+		if conn, ok := c.snapshotConnection(ctx, id); ok {
+			return conn, nil
+		}
+	}
+
 	var conn Connection
 	err := c.Get(ctx, "/connections/"+url.PathEscape(id), &conn)
 	if err != nil {
@@ -88,3 +97,52 @@ func (c *Client) GetConnectionByOrganizationAndType(ctx context.Context, organiz
 
 	return &matches[0], nil
 }
+
+// SAMLMetadata is the service provider metadata document WorkOS publishes for
+// a SAML connection.
+type SAMLMetadata struct {
+	// EntityID is the entityID of the document's EntityDescriptor.
+	EntityID string
+
+	// XML is the document as returned by WorkOS.
+	XML string
+}
+
+// GetSAMLMetadata downloads the service provider metadata document of a SAML
+// connection from its public metadata URL. The URL is served without
+// authentication, so the API key is not sent with the request.
+func (c *Client) GetSAMLMetadata(ctx context.Context, metadataURL string) (*SAMLMetadata, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/samlmetadata+xml, application/xml, text/xml")
+	req.Header.Set("User-Agent", "terraform-provider-workos")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SAML metadata: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SAML metadata: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("failed to get SAML metadata: %w", parseAPIError(resp.StatusCode, body))
+	}
+
+	var descriptor struct {
+		XMLName  xml.Name
+		EntityID string `xml:"entityID,attr"`
+	}
+	if err := xml.Unmarshal(body, &descriptor); err != nil {
+		return nil, fmt.Errorf("failed to decode SAML metadata: %w", err)
+	}
+	if descriptor.XMLName.Local != "EntityDescriptor" {
+		return nil, fmt.Errorf("failed to decode SAML metadata: expected an EntityDescriptor document, got %s", descriptor.XMLName.Local)
+	}
+
+	return &SAMLMetadata{EntityID: descriptor.EntityID, XML: string(body)}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 // DirectoryListResponse represents the response from listing directories
@@ -73,32 +74,77 @@ func (c *Client) ListDirectories(ctx context.Context, organizationID string) (*D
 	return &all, nil
 }
 
-// GetDirectoryByOrganization finds a directory by organization ID
-func (c *Client) GetDirectoryByOrganization(ctx context.Context, organizationID string) (*Directory, error) {
+// GetDirectoryByOrganization finds a single directory by organization ID,
+// optionally narrowed by exact name and type. Returns an error if no
+// directories or multiple directories match.
+func (c *Client) GetDirectoryByOrganization(ctx context.Context, organizationID, name, directoryType string) (*Directory, error) {
 	resp, err := c.ListDirectories(ctx, organizationID)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.Data) == 0 {
+	matches := make([]Directory, 0)
+	for _, dir := range resp.Data {
+		if name != "" && dir.Name != name {
+			continue
+		}
+		if directoryType != "" && dir.Type != directoryType {
+			continue
+		}
+		matches = append(matches, dir)
+	}
+
+	if len(matches) == 0 {
 		return nil, &APIError{
 			StatusCode: 404,
-			Message:    fmt.Sprintf("no directory found for organization %s", organizationID),
+			Message:    fmt.Sprintf("no directory found for organization %s%s", organizationID, directoryFilterDescription(name, directoryType)),
 		}
 	}
 
-	return &resp.Data[0], nil
+	if len(matches) > 1 {
+		dirIDs := make([]string, len(matches))
+		for i, dir := range matches {
+			dirIDs[i] = fmt.Sprintf("%s (%s, %s)", dir.ID, dir.Name, dir.Type)
+		}
+		return nil, fmt.Errorf(
+			"ambiguous directory lookup: found %d directories for organization %s%s: [%s]. "+
+				"Set name or type to select a single directory",
+			len(matches), organizationID, directoryFilterDescription(name, directoryType), strings.Join(dirIDs, ", "),
+		)
+	}
+
+	return &matches[0], nil
+}
+
+func directoryFilterDescription(name, directoryType string) string {
+	var filters []string
+	if name != "" {
+		filters = append(filters, fmt.Sprintf("name %q", name))
+	}
+	if directoryType != "" {
+		filters = append(filters, fmt.Sprintf("type %q", directoryType))
+	}
+	if len(filters) == 0 {
+		return ""
+	}
+	return " with " + strings.Join(filters, " and ")
 }
 
-// ListDirectoryUsers lists users in a directory
-func (c *Client) ListDirectoryUsers(ctx context.Context, directoryID string) (*DirectoryUserListResponse, error) {
-	return c.listDirectoryUsers(ctx, directoryID, "")
+// ListDirectoryUsers lists users in a directory, in a directory group, or in
+// both when both IDs are set. The group filter is applied by the API.
+func (c *Client) ListDirectoryUsers(ctx context.Context, directoryID, groupID string) (*DirectoryUserListResponse, error) {
+	return c.listDirectoryUsers(ctx, directoryID, groupID, "")
 }
 
-func (c *Client) listDirectoryUsers(ctx context.Context, directoryID, email string) (*DirectoryUserListResponse, error) {
+func (c *Client) listDirectoryUsers(ctx context.Context, directoryID, groupID, email string) (*DirectoryUserListResponse, error) {
 	var all DirectoryUserListResponse
 	params := url.Values{}
-	params.Set("directory", directoryID)
+	if directoryID != "" {
+		params.Set("directory", directoryID)
+	}
+	if groupID != "" {
+		params.Set("group", groupID)
+	}
 	if email != "" {
 		params.Set("emails", email)
 	}
@@ -134,7 +180,7 @@ func (c *Client) GetDirectoryUser(ctx context.Context, id string) (*DirectoryUse
 
 // GetDirectoryUserByEmail finds a directory user by email
 func (c *Client) GetDirectoryUserByEmail(ctx context.Context, directoryID, email string) (*DirectoryUser, error) {
-	resp, err := c.listDirectoryUsers(ctx, directoryID, email)
+	resp, err := c.listDirectoryUsers(ctx, directoryID, "", email)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search directory users: %w", err)
 	}
@@ -149,11 +195,39 @@ func (c *Client) GetDirectoryUserByEmail(ctx context.Context, directoryID, email
 	return &resp.Data[0], nil
 }
 
-// ListDirectoryGroups lists groups in a directory
-func (c *Client) ListDirectoryGroups(ctx context.Context, directoryID string) (*DirectoryGroupListResponse, error) {
+// GetDirectoryUserByIdpID finds a directory user by the user's ID in the
+// identity provider. The API has no idp_id filter, so the directory's users
+// are listed and matched here.
+func (c *Client) GetDirectoryUserByIdpID(ctx context.Context, directoryID, idpID string) (*DirectoryUser, error) {
+	resp, err := c.ListDirectoryUsers(ctx, directoryID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to search directory users: %w", err)
+	}
+
+	for _, user := range resp.Data {
+		if user.IdpID == idpID {
+			return &user, nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode: 404,
+		Message:    fmt.Sprintf("no user found with idp_id %s in directory %s", idpID, directoryID),
+	}
+}
+
+// ListDirectoryGroups lists groups in a directory, the groups a directory user
+// belongs to, or both when both IDs are set. The user filter is applied by the
+// API.
+func (c *Client) ListDirectoryGroups(ctx context.Context, directoryID, userID string) (*DirectoryGroupListResponse, error) {
 	var all DirectoryGroupListResponse
 	params := url.Values{}
-	params.Set("directory", directoryID)
+	if directoryID != "" {
+		params.Set("directory", directoryID)
+	}
+	if userID != "" {
+		params.Set("user", userID)
+	}
 	applyDefaultPagination(params)
 
 	for {
@@ -184,9 +258,32 @@ func (c *Client) GetDirectoryGroup(ctx context.Context, id string) (*DirectoryGr
 	return &group, nil
 }
 
-// GetDirectoryGroupByName finds a directory group by name
+// GetDirectoryGroupByIdpID finds a directory group by the group's ID in the
+// identity provider, which, unlike the name, survives a rename in the IdP.
+// The API has no idp_id filter, so the directory's groups are listed and
+// matched here.
+func (c *Client) GetDirectoryGroupByIdpID(ctx context.Context, directoryID, idpID string) (*DirectoryGroup, error) {
+	resp, err := c.ListDirectoryGroups(ctx, directoryID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to search directory groups: %w", err)
+	}
+
+	for _, group := range resp.Data {
+		if group.IdpID == idpID {
+			return &group, nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode: 404,
+		Message:    fmt.Sprintf("no group found with idp_id %s in directory %s", idpID, directoryID),
+	}
+}
+
+// GetDirectoryGroupByName finds a directory group by name, searching every
+// page of the directory's groups.
 func (c *Client) GetDirectoryGroupByName(ctx context.Context, directoryID, name string) (*DirectoryGroup, error) {
-	resp, err := c.ListDirectoryGroups(ctx, directoryID)
+	resp, err := c.ListDirectoryGroups(ctx, directoryID, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to search directory groups: %w", err)
 	}
@@ -0,0 +1,244 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const organizationDirectoriesFixture = `{
+  "data": [
+    {"id": "directory_hr", "organization_id": "org_123", "name": "Workday", "type": "workday", "state": "linked"},
+    {"id": "directory_scim", "organization_id": "org_123", "name": "Okta", "type": "okta scim v2.0", "state": "linked"}
+  ],
+  "list_metadata": {}
+}`
+
+func newOrganizationDirectoriesServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/directories" {
+			t.Fatalf("expected /directories, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("organization_id"); got != "org_123" {
+			t.Fatalf("expected organization_id=org_123, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(organizationDirectoriesFixture))
+	}))
+}
+
+func TestDirectoriesClientGetByOrganizationFilters(t *testing.T) {
+	server := newOrganizationDirectoriesServer(t)
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dir, err := client.GetDirectoryByOrganization(context.Background(), "org_123", "", "okta scim v2.0")
+	if err != nil {
+		t.Fatalf("GetDirectoryByOrganization returned error: %v", err)
+	}
+	if dir.ID != "directory_scim" {
+		t.Fatalf("expected directory_scim, got %s", dir.ID)
+	}
+
+	dir, err = client.GetDirectoryByOrganization(context.Background(), "org_123", "Workday", "")
+	if err != nil {
+		t.Fatalf("GetDirectoryByOrganization returned error: %v", err)
+	}
+	if dir.ID != "directory_hr" {
+		t.Fatalf("expected directory_hr, got %s", dir.ID)
+	}
+
+	_, err = client.GetDirectoryByOrganization(context.Background(), "org_123", "Workday", "okta scim v2.0")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestDirectoriesClientGetByOrganizationAmbiguous(t *testing.T) {
+	server := newOrganizationDirectoriesServer(t)
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	_, err = client.GetDirectoryByOrganization(context.Background(), "org_123", "", "")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous directory lookup") {
+		t.Fatalf("expected ambiguity error, got %v", err)
+	}
+}
+
+func TestDirectoriesClientListDirectoryUsersByGroup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/directory_users" {
+			t.Fatalf("expected /directory_users, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("group"); got != "directory_group_eng" {
+			t.Fatalf("expected group=directory_group_eng, got %q", got)
+		}
+		if r.URL.Query().Has("directory") {
+			t.Fatalf("expected no directory filter, got %q", r.URL.Query().Get("directory"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"directory_user_1","email":"jane@example.com"}],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	users, err := client.ListDirectoryUsers(context.Background(), "", "directory_group_eng")
+	if err != nil {
+		t.Fatalf("ListDirectoryUsers returned error: %v", err)
+	}
+	if len(users.Data) != 1 || users.Data[0].ID != "directory_user_1" {
+		t.Fatalf("unexpected users: %#v", users.Data)
+	}
+}
+
+func TestDirectoriesClientListDirectoryGroupsByUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/directory_groups" {
+			t.Fatalf("expected /directory_groups, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("user"); got != "directory_user_1" {
+			t.Fatalf("expected user=directory_user_1, got %q", got)
+		}
+		if r.URL.Query().Has("directory") {
+			t.Fatalf("expected no directory filter, got %q", r.URL.Query().Get("directory"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"directory_group_eng","name":"Engineering"}],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	groups, err := client.ListDirectoryGroups(context.Background(), "", "directory_user_1")
+	if err != nil {
+		t.Fatalf("ListDirectoryGroups returned error: %v", err)
+	}
+	if len(groups.Data) != 1 || groups.Data[0].ID != "directory_group_eng" {
+		t.Fatalf("unexpected groups: %#v", groups.Data)
+	}
+}
+
+func TestDirectoriesClientGetDirectoryUserByIdpID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/directory_users" {
+			t.Fatalf("expected /directory_users, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("directory"); got != "directory_123" {
+			t.Fatalf("expected directory=directory_123, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"directory_user_1","idp_id":"00u1"},{"id":"directory_user_2","idp_id":"00u2"}],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	user, err := client.GetDirectoryUserByIdpID(context.Background(), "directory_123", "00u2")
+	if err != nil {
+		t.Fatalf("GetDirectoryUserByIdpID returned error: %v", err)
+	}
+	if user.ID != "directory_user_2" {
+		t.Fatalf("expected directory_user_2, got %s", user.ID)
+	}
+
+	_, err = client.GetDirectoryUserByIdpID(context.Background(), "directory_123", "00u3")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestDirectoriesClientGetDirectoryGroupByIdpID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/directory_groups" {
+			t.Fatalf("expected /directory_groups, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"directory_group_1","idp_id":"group-1","name":"Engineering"}],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	group, err := client.GetDirectoryGroupByIdpID(context.Background(), "directory_123", "group-1")
+	if err != nil {
+		t.Fatalf("GetDirectoryGroupByIdpID returned error: %v", err)
+	}
+	if group.ID != "directory_group_1" {
+		t.Fatalf("expected directory_group_1, got %s", group.ID)
+	}
+
+	_, err = client.GetDirectoryGroupByIdpID(context.Background(), "directory_123", "group-2")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestDirectoriesClientGetDirectoryGroupByNamePaginates(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/directory_groups" {
+			t.Fatalf("expected /directory_groups, got %s", r.URL.Path)
+		}
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("after") == "" {
+			_, _ = w.Write([]byte(`{"data":[{"id":"directory_group_1","name":"Engineering"}],"list_metadata":{"after":"directory_group_1"}}`))
+			return
+		}
+		if got := r.URL.Query().Get("after"); got != "directory_group_1" {
+			t.Fatalf("expected after=directory_group_1, got %q", got)
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"directory_group_2","name":"Operations"}],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	group, err := client.GetDirectoryGroupByName(context.Background(), "directory_123", "Operations")
+	if err != nil {
+		t.Fatalf("GetDirectoryGroupByName returned error: %v", err)
+	}
+	if group.ID != "directory_group_2" {
+		t.Fatalf("expected directory_group_2, got %s", group.ID)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// InvitationListResponse represents the response from listing invitations
+type InvitationListResponse struct {
+	Data         []Invitation `json:"data"`
+	ListMetadata ListMetadata `json:"list_metadata"`
+}
+
+// SendInvitation sends an invitation email to join an organization or the environment
+func (c *Client) SendInvitation(ctx context.Context, req *InvitationCreateRequest) (*Invitation, error) {
+	var invitation Invitation
+	err := c.Post(ctx, "/user_management/invitations", req, &invitation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send invitation: %w", err)
+	}
+	return &invitation, nil
+}
+
+// GetInvitation retrieves an invitation by ID
+func (c *Client) GetInvitation(ctx context.Context, id string) (*Invitation, error) {
+	var invitation Invitation
+	err := c.Get(ctx, "/user_management/invitations/"+url.PathEscape(id), &invitation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	return &invitation, nil
+}
+
+// ListInvitations lists invitations with optional email and organization filters
+func (c *Client) ListInvitations(ctx context.Context, email string, organizationID string) (*InvitationListResponse, error) {
+	var all InvitationListResponse
+	params := url.Values{}
+	if email != "" {
+		params.Set("email", email)
+	}
+	if organizationID != "" {
+		params.Set("organization_id", organizationID)
+	}
+	applyDefaultPagination(params)
+
+	for {
+		var page InvitationListResponse
+		err := c.Get(ctx, pathWithQuery("/user_management/invitations", params), &page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list invitations: %w", err)
+		}
+
+		all.Data = append(all.Data, page.Data...)
+		all.ListMetadata = page.ListMetadata
+		if page.ListMetadata.After == "" {
+			break
+		}
+		params.Set("after", page.ListMetadata.After)
+	}
+
+	return &all, nil
+}
+
+// RevokeInvitation revokes a pending invitation by ID
+func (c *Client) RevokeInvitation(ctx context.Context, id string) (*Invitation, error) {
+	var invitation Invitation
+	err := c.Post(ctx, "/user_management/invitations/"+url.PathEscape(id)+"/revoke", nil, &invitation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke invitation: %w", err)
+	}
+	return &invitation, nil
+}
+
+// ResendInvitation re-sends a pending or expired invitation email by ID
+func (c *Client) ResendInvitation(ctx context.Context, id string) (*Invitation, error) {
+	var invitation Invitation
+	err := c.Post(ctx, "/user_management/invitations/"+url.PathEscape(id)+"/resend", nil, &invitation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resend invitation: %w", err)
+	}
+	return &invitation, nil
+}
@@ -0,0 +1,146 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestInvitationsClientListPaginates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/user_management/invitations" {
+			t.Fatalf("expected /user_management/invitations, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("organization_id"); got != "org_123" {
+			t.Fatalf("expected organization_id=org_123, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("after") == "" {
+			_, _ = w.Write([]byte(`{"data":[{"id":"invitation_1","email":"a@example.com","state":"pending"}],"list_metadata":{"after":"invitation_1"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"invitation_2","email":"b@example.com","state":"accepted"}],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	invitations, err := client.ListInvitations(context.Background(), "", "org_123")
+	if err != nil {
+		t.Fatalf("ListInvitations returned error: %v", err)
+	}
+	if len(invitations.Data) != 2 || invitations.Data[1].State != "accepted" {
+		t.Fatalf("unexpected invitations: %#v", invitations.Data)
+	}
+}
+
+func TestInvitationsClientRevoke(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/user_management/invitations/invitation_1/revoke" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"invitation_1","email":"a@example.com","state":"revoked","revoked_at":"2026-01-15T12:00:00.000Z"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	invitation, err := client.RevokeInvitation(context.Background(), "invitation_1")
+	if err != nil {
+		t.Fatalf("RevokeInvitation returned error: %v", err)
+	}
+	if invitation.State != "revoked" || invitation.RevokedAt == nil {
+		t.Fatalf("unexpected invitation: %#v", invitation)
+	}
+}
+
+func TestInvitationsClientResend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/user_management/invitations/invitation_1/resend" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"invitation_1","email":"a@example.com","state":"pending","expires_at":"2026-01-22T12:00:00.000Z"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	invitation, err := client.ResendInvitation(context.Background(), "invitation_1")
+	if err != nil {
+		t.Fatalf("ResendInvitation returned error: %v", err)
+	}
+	if invitation.State != "pending" || invitation.ExpiresAt.IsZero() {
+		t.Fatalf("unexpected invitation: %#v", invitation)
+	}
+}
+
+func TestInvitationsClientSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/user_management/invitations" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if body["email"] != "a@example.com" || body["organization_id"] != "org_123" || body["role_slug"] != "admin" {
+			t.Fatalf("unexpected request body: %#v", body)
+		}
+		if _, exists := body["expires_in_days"]; exists {
+			t.Fatalf("expected expires_in_days to be omitted, got %#v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"invitation_1","email":"a@example.com","state":"pending","organization_id":"org_123","expires_at":"2026-01-22T12:00:00.000Z"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	invitation, err := client.SendInvitation(context.Background(), &InvitationCreateRequest{
+		Email:          "a@example.com",
+		OrganizationID: "org_123",
+		RoleSlug:       "admin",
+	})
+	if err != nil {
+		t.Fatalf("SendInvitation returned error: %v", err)
+	}
+	if invitation.ID != "invitation_1" || invitation.State != "pending" {
+		t.Fatalf("unexpected invitation: %#v", invitation)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// JWK is a public key from a JSON Web Key Set.
+type JWK struct {
+	Kid     string   `json:"kid"`
+	Kty     string   `json:"kty"`
+	Alg     string   `json:"alg,omitempty"`
+	Use     string   `json:"use,omitempty"`
+	N       string   `json:"n,omitempty"`
+	E       string   `json:"e,omitempty"`
+	X5C     []string `json:"x5c,omitempty"`
+	X5TS256 string   `json:"x5t#S256,omitempty"`
+}
+
+// JWKS is the JSON Web Key Set WorkOS signs access tokens and sessions with.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+
+	// Raw is the document as returned by WorkOS.
+	Raw json.RawMessage `json:"-"`
+}
+
+// JWKSURL returns the public URL of the JSON Web Key Set for a client ID.
+func (c *Client) JWKSURL(clientID string) string {
+	return strings.TrimSuffix(c.baseURL, "/") + jwksPath(clientID)
+}
+
+// GetJWKS retrieves the JSON Web Key Set for a client ID.
+func (c *Client) GetJWKS(ctx context.Context, clientID string) (*JWKS, error) {
+	var raw json.RawMessage
+	err := c.Get(ctx, jwksPath(clientID), &raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get JWKS: %w", err)
+	}
+
+	jwks := JWKS{Raw: raw}
+	if err := json.Unmarshal(raw, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+	return &jwks, nil
+}
+
+func jwksPath(clientID string) string {
+	return "/sso/jwks/" + url.PathEscape(clientID)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem API requests are logged to. Its level
+// can be raised on its own with TF_LOG_PROVIDER_WORKOS_CLIENT, for example to
+// debug, without enabling the framework's logs.
+const LogSubsystem = "workos_client"
+
+// logLevelEnv is the environment variable tflog reads the subsystem's level
+// from.
+const logLevelEnv = "TF_LOG_PROVIDER_WORKOS_CLIENT"
+
+// LogLevels are the levels the client's log subsystem can be set to.
+var LogLevels = []string{"trace", "debug", "info", "warn", "error", "off"}
+
+// SetLogLevel sets the level of the client's log subsystem. An empty level
+// inherits the provider's. TF_LOG_PROVIDER_WORKOS_CLIENT takes precedence
+// when it is set.
+func (c *Client) SetLogLevel(level string) {
+	c.logLevel = hclog.LevelFromString(level)
+}
+
+// logContext returns a context carrying the client's log subsystem. The
+// subsystem includes the provider's root fields, such as tf_resource_type
+// and tf_data_source_type, so every request can be traced back to the
+// resource or data source that made it.
+func (c *Client) logContext(ctx context.Context, method, path string) context.Context {
+	level := c.logLevel
+	if env := hclog.LevelFromString(os.Getenv(logLevelEnv)); env != hclog.NoLevel {
+		level = env
+	}
+
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevel(level), tflog.WithRootFields())
+	return tflog.SubsystemSetField(ctx, LogSubsystem, "operation", method+" "+operationPath(path))
+}
+
+// operationPath strips the query string from a request path, as queries can
+// hold email addresses and other lookup values.
+func operationPath(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		return path[:i]
+	}
+	return path
+}
+
+func logRequest(ctx context.Context, attempt int) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending WorkOS API request", map[string]any{
+		"attempt": attempt + 1,
+	})
+}
+
+func logResponse(ctx context.Context, attempt int, resp *http.Response, elapsed time.Duration) {
+	fields := map[string]any{
+		"attempt":     attempt + 1,
+		"status_code": resp.StatusCode,
+		"duration_ms": elapsed.Milliseconds(),
+	}
+	if requestID := resp.Header.Get("X-Request-ID"); requestID != "" {
+		fields["request_id"] = requestID
+	}
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received WorkOS API response", fields)
+}
+
+func logRequestError(ctx context.Context, attempt int, err error) {
+	tflog.SubsystemError(ctx, LogSubsystem, "WorkOS API request failed", map[string]any{
+		"attempt": attempt + 1,
+		"error":   err.Error(),
+	})
+}
+
+func logRetry(ctx context.Context, attempt int, statusCode int, delay time.Duration) {
+	tflog.SubsystemWarn(ctx, LogSubsystem, "Retrying WorkOS API request", map[string]any{
+		"attempt":     attempt + 1,
+		"status_code": statusCode,
+		"delay_ms":    delay.Milliseconds(),
+	})
+}
+
+// logUsage logs the API traffic this client has sent so far. It is logged
+// after every request, so the last entry of a plan or apply holds its totals.
+func logUsage(ctx context.Context, metrics Metrics) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "WorkOS API usage", map[string]any{
+		"requests":     metrics.Requests,
+		"retries":      metrics.Retries,
+		"rate_limited": metrics.RateLimited,
+	})
+}
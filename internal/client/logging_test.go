@@ -0,0 +1,138 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestClientLogsRequestsToSubsystem(t *testing.T) {
+	testCases := map[string]struct {
+		level          string
+		env            string
+		expectAttempts []any
+	}{
+		"info": {
+			// Only the retry warning is logged at info and above.
+			level:          "info",
+			expectAttempts: []any{float64(1)},
+		},
+		"debug": {
+			level:          "debug",
+			expectAttempts: []any{float64(1), float64(1), float64(1), float64(2), float64(2)},
+		},
+		"environment overrides": {
+			level: "debug",
+			env:   "error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(logLevelEnv, tc.env)
+
+			var calls int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Content-Type", "application/json")
+				if calls == 1 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				_, _ = w.Write([]byte(`{"data":[],"list_metadata":{}}`))
+			}))
+			defer server.Close()
+
+			client, err := NewClient("sk_test", "", server.URL)
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			client.SetLogLevel(tc.level)
+
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+			ctx = tflog.SetField(ctx, "tf_resource_type", "workos_user")
+
+			if _, err := client.ListUsers(ctx, "jane@example.com", ""); err != nil {
+				t.Fatalf("ListUsers returned error: %v", err)
+			}
+
+			entries, err := tflogtest.MultilineJSONDecode(&output)
+			if err != nil {
+				t.Fatalf("failed to decode log output: %v", err)
+			}
+
+			var attempts []any
+			for _, entry := range entries {
+				if entry["@module"] != "provider."+LogSubsystem || entry["@message"] == "WorkOS API usage" {
+					continue
+				}
+				if entry["operation"] != "GET /user_management/users" {
+					t.Fatalf("expected the operation without its query, got %v", entry["operation"])
+				}
+				if entry["tf_resource_type"] != "workos_user" {
+					t.Fatalf("expected the resource type root field, got %v", entry)
+				}
+				attempts = append(attempts, entry["attempt"])
+			}
+			if fmt.Sprint(attempts) != fmt.Sprint(tc.expectAttempts) {
+				t.Fatalf("expected log lines for attempts %v, got %v", tc.expectAttempts, entries)
+			}
+		})
+	}
+}
+
+func TestClientLogsUsageAfterEachRequest(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"org_123","name":"Acme"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.SetLogLevel("debug")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	for range 2 {
+		if _, err := client.GetOrganization(ctx, "org_123"); err != nil {
+			t.Fatalf("GetOrganization returned error: %v", err)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %v", err)
+	}
+
+	var usage []string
+	for _, entry := range entries {
+		if entry["@message"] == "WorkOS API usage" {
+			usage = append(usage, fmt.Sprintf("%v/%v/%v", entry["requests"], entry["retries"], entry["rate_limited"]))
+		}
+	}
+	expected := []string{"2/1/1", "3/1/1"}
+	if fmt.Sprint(usage) != fmt.Sprint(expected) {
+		t.Fatalf("expected usage entries %v, got %v", expected, usage)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Metrics summarises the API traffic sent by a client.
+type Metrics struct {
+	// Requests is the number of HTTP requests sent, including retries.
+	Requests int64
+	// Retries is the number of requests that were retries of an earlier attempt.
+	Retries int64
+	// RateLimited is the number of 429 responses received.
+	RateLimited int64
+	// Maintenance is the number of 503 maintenance responses that were retried.
+	Maintenance int64
+	// MaintenanceWait is the total time spent waiting out maintenance.
+	MaintenanceWait time.Duration
+}
+
+type metricsCounter struct {
+	requests    atomic.Int64
+	retries     atomic.Int64
+	rateLimited atomic.Int64
+	maintenance atomic.Int64
+	// maintenanceWait is stored in nanoseconds.
+	maintenanceWait atomic.Int64
+}
+
+func (m *metricsCounter) snapshot() Metrics {
+	return Metrics{
+		Requests:        m.requests.Load(),
+		Retries:         m.retries.Load(),
+		RateLimited:     m.rateLimited.Load(),
+		Maintenance:     m.maintenance.Load(),
+		MaintenanceWait: time.Duration(m.maintenanceWait.Load()),
+	}
+}
+
+// maintenanceDelay accumulates maintenance waits that have not been reported
+// to the user yet.
+type maintenanceDelay struct {
+	mu        sync.Mutex
+	responses int64
+	wait      time.Duration
+}
+
+func (c *Client) recordRequest(attempt int) {
+	c.metrics.requests.Add(1)
+	if attempt > 0 {
+		c.metrics.retries.Add(1)
+	}
+}
+
+func (c *Client) recordRateLimited() {
+	c.metrics.rateLimited.Add(1)
+}
+
+func (c *Client) recordMaintenanceWait(delay time.Duration) {
+	c.metrics.maintenance.Add(1)
+	c.metrics.maintenanceWait.Add(int64(delay))
+
+	c.pendingMaintenance.mu.Lock()
+	defer c.pendingMaintenance.mu.Unlock()
+	c.pendingMaintenance.responses++
+	c.pendingMaintenance.wait += delay
+}
+
+// Metrics returns the API traffic sent by this client so far.
+func (c *Client) Metrics() Metrics {
+	return c.metrics.snapshot()
+}
+
+// TakeMaintenanceDelay returns the number of 503 maintenance responses retried
+// and the time spent waiting them out since the last call, so each delay is
+// reported once.
+func (c *Client) TakeMaintenanceDelay() (int64, time.Duration) {
+	c.pendingMaintenance.mu.Lock()
+	defer c.pendingMaintenance.mu.Unlock()
+
+	responses, wait := c.pendingMaintenance.responses, c.pendingMaintenance.wait
+	c.pendingMaintenance.responses, c.pendingMaintenance.wait = 0, 0
+	return responses, wait
+}
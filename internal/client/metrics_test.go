@@ -0,0 +1,101 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientMetricsCountRetriesAndRateLimits(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"Too many requests"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"org_123","name":"Acme"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, err := client.GetOrganization(context.Background(), "org_123"); err != nil {
+		t.Fatalf("GetOrganization returned error: %v", err)
+	}
+
+	expected := Metrics{Requests: 2, Retries: 1, RateLimited: 1}
+	if got := client.Metrics(); got != expected {
+		t.Fatalf("expected client metrics %+v, got %+v", expected, got)
+	}
+}
+
+func TestClientRetriesMaintenanceWithRetryAfter(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"message":"Scheduled maintenance"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"org_123","name":"Acme"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, err := client.GetOrganization(context.Background(), "org_123"); err != nil {
+		t.Fatalf("GetOrganization returned error: %v", err)
+	}
+
+	expected := Metrics{Requests: 2, Retries: 1, Maintenance: 1}
+	if got := client.Metrics(); got != expected {
+		t.Fatalf("expected client metrics %+v, got %+v", expected, got)
+	}
+
+	if responses, _ := client.TakeMaintenanceDelay(); responses != 1 {
+		t.Fatalf("expected one pending maintenance response, got %d", responses)
+	}
+	if responses, _ := client.TakeMaintenanceDelay(); responses != 0 {
+		t.Fatalf("expected the maintenance delay to be reported once, got %d", responses)
+	}
+}
+
+func TestClientDoesNotRetryServiceUnavailableWithoutRetryAfter(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"message":"Service unavailable"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, err := client.GetOrganization(context.Background(), "org_123"); err == nil {
+		t.Fatal("expected an error for a 503 response")
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single request, got %d", calls)
+	}
+}
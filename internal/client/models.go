@@ -7,14 +7,15 @@ import "time"
 
 // Organization represents a WorkOS Organization
 type Organization struct {
-	ID         string            `json:"id"`
-	Object     string            `json:"object"`
-	Name       string            `json:"name"`
-	ExternalID string            `json:"external_id,omitempty"`
-	Metadata   map[string]string `json:"metadata,omitempty"`
-	Domains    []Domain          `json:"domains,omitempty"`
-	CreatedAt  time.Time         `json:"created_at"`
-	UpdatedAt  time.Time         `json:"updated_at"`
+	ID               string            `json:"id"`
+	Object           string            `json:"object"`
+	Name             string            `json:"name"`
+	ExternalID       string            `json:"external_id,omitempty"`
+	StripeCustomerID string            `json:"stripe_customer_id,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+	Domains          []Domain          `json:"domains,omitempty"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
 }
 
 // Domain represents a domain associated with an organization
@@ -29,10 +30,11 @@ type Domain struct {
 
 // OrganizationCreateRequest represents the request to create an organization
 type OrganizationCreateRequest struct {
-	Name       string            `json:"name"`
-	DomainData []DomainData      `json:"domain_data,omitempty"`
-	ExternalID string            `json:"external_id,omitempty"`
-	Metadata   map[string]string `json:"metadata,omitempty"`
+	Name             string            `json:"name"`
+	DomainData       []DomainData      `json:"domain_data,omitempty"`
+	ExternalID       string            `json:"external_id,omitempty"`
+	StripeCustomerID string            `json:"stripe_customer_id,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
 }
 
 // DomainData represents domain data for organization creation/update
@@ -43,10 +45,11 @@ type DomainData struct {
 
 // OrganizationUpdateRequest represents the request to update an organization
 type OrganizationUpdateRequest struct {
-	Name       string             `json:"name,omitempty"`
-	DomainData []DomainData       `json:"domain_data,omitempty"`
-	ExternalID string             `json:"external_id,omitempty"`
-	Metadata   map[string]*string `json:"metadata,omitempty"`
+	Name             string             `json:"name,omitempty"`
+	DomainData       []DomainData       `json:"domain_data,omitempty"`
+	ExternalID       string             `json:"external_id,omitempty"`
+	StripeCustomerID *string            `json:"stripe_customer_id,omitempty"` // nil leaves it unchanged; an empty string clears it
+	Metadata         map[string]*string `json:"metadata,omitempty"`
 }
 
 // OrganizationListResponse represents the response from listing organizations
@@ -83,6 +86,7 @@ type SAMLConfiguration struct {
 	IdPCertificate string `json:"idp_certificate"`
 	SPEntityID     string `json:"sp_entity_id"`
 	SPACSURL       string `json:"sp_acs_url"`
+	SPMetadataURL  string `json:"sp_metadata_url,omitempty"`
 }
 
 // OIDCConfiguration represents OIDC-specific configuration
@@ -127,14 +131,15 @@ type DirectoryUser struct {
 
 // DirectoryGroup represents a group synced from a directory
 type DirectoryGroup struct {
-	ID             string    `json:"id"`
-	Object         string    `json:"object"`
-	DirectoryID    string    `json:"directory_id"`
-	OrganizationID string    `json:"organization_id"`
-	IdpID          string    `json:"idp_id"`
-	Name           string    `json:"name"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	ID             string                 `json:"id"`
+	Object         string                 `json:"object"`
+	DirectoryID    string                 `json:"directory_id"`
+	OrganizationID string                 `json:"organization_id"`
+	IdpID          string                 `json:"idp_id"`
+	Name           string                 `json:"name"`
+	RawAttributes  map[string]interface{} `json:"raw_attributes,omitempty"`
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      time.Time              `json:"updated_at"`
 }
 
 // User represents a WorkOS AuthKit User
@@ -176,6 +181,14 @@ type UserUpdateRequest struct {
 	Metadata      map[string]*string `json:"metadata,omitempty"`
 }
 
+// UserAnonymizeRequest represents the update that clears a user's personal
+// data. Names are always sent so that empty values overwrite existing ones.
+type UserAnonymizeRequest struct {
+	FirstName string             `json:"first_name"`
+	LastName  string             `json:"last_name"`
+	Metadata  map[string]*string `json:"metadata,omitempty"`
+}
+
 // OrganizationMembershipRole represents the role assigned in a membership
 type OrganizationMembershipRole struct {
 	Slug string `json:"slug"`
@@ -207,6 +220,32 @@ type OrganizationMembershipUpdateRequest struct {
 	RoleSlugs []string `json:"role_slugs,omitempty"`
 }
 
+// InvitationCreateRequest represents the request to send an invitation
+type InvitationCreateRequest struct {
+	Email          string `json:"email"`
+	OrganizationID string `json:"organization_id,omitempty"`
+	RoleSlug       string `json:"role_slug,omitempty"`
+	ExpiresInDays  int64  `json:"expires_in_days,omitempty"`
+	InviterUserID  string `json:"inviter_user_id,omitempty"`
+}
+
+// Invitation represents a WorkOS User Management invitation
+type Invitation struct {
+	ID                  string     `json:"id"`
+	Object              string     `json:"object"`
+	Email               string     `json:"email"`
+	State               string     `json:"state"`
+	AcceptedAt          *time.Time `json:"accepted_at"`
+	RevokedAt           *time.Time `json:"revoked_at"`
+	ExpiresAt           time.Time  `json:"expires_at"`
+	Token               string     `json:"token"`
+	AcceptInvitationURL string     `json:"accept_invitation_url"`
+	OrganizationID      string     `json:"organization_id,omitempty"`
+	InviterUserID       string     `json:"inviter_user_id,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
 // OrganizationRole represents a WorkOS Organization Role
 type OrganizationRole struct {
 	ID               string    `json:"id"`
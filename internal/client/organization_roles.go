@@ -91,17 +91,30 @@ func (c *Client) ListOrganizationRoles(ctx context.Context, orgID string) (*Orga
 	return &all, nil
 }
 
-// GetOrganizationRoleByID finds an organization role by its ID
+// GetOrganizationRoleByID finds an organization role by its ID. Roles are
+// listed a page at a time and the scan stops at the page containing the role.
 func (c *Client) GetOrganizationRoleByID(ctx context.Context, orgID, roleID string) (*OrganizationRole, error) {
-	resp, err := c.ListOrganizationRoles(ctx, orgID)
-	if err != nil {
-		return nil, err
-	}
+	params := url.Values{}
+	applyDefaultPagination(params)
+	path := fmt.Sprintf("/authorization/organizations/%s/roles", url.PathEscape(orgID))
 
-	for _, role := range resp.Data {
-		if role.ID == roleID {
-			return &role, nil
+	for {
+		var page OrganizationRoleListResponse
+		err := c.Get(ctx, pathWithQuery(path, params), &page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organization roles: %w", err)
+		}
+
+		for _, role := range page.Data {
+			if role.ID == roleID {
+				return &role, nil
+			}
+		}
+
+		if page.ListMetadata.After == "" {
+			break
 		}
+		params.Set("after", page.ListMetadata.After)
 	}
 
 	return nil, &APIError{
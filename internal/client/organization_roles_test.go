@@ -226,3 +226,50 @@ func TestOrganizationRoleMutationLockHonorsContextCancellation(t *testing.T) {
 		t.Fatalf("first CreateOrganizationRole returned error: %v", createErr)
 	}
 }
+
+func TestGetOrganizationRoleByIDPaginatesAndStopsWhenFound(t *testing.T) {
+	var afters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/authorization/organizations/org_123/roles" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		after := r.URL.Query().Get("after")
+		afters = append(afters, after)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch after {
+		case "":
+			_, _ = w.Write([]byte(`{"data":[{"id":"role_1","slug":"viewer"}],"list_metadata":{"after":"role_1"}}`))
+		case "role_1":
+			_, _ = w.Write([]byte(`{"data":[{"id":"role_2","slug":"editor"}],"list_metadata":{"after":"role_2"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":[{"id":"role_3","slug":"owner"}],"list_metadata":{}}`))
+		}
+	}))
+	defer server.Close()
+
+	workosClient, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	role, err := workosClient.GetOrganizationRoleByID(context.Background(), "org_123", "role_2")
+	if err != nil {
+		t.Fatalf("GetOrganizationRoleByID returned error: %v", err)
+	}
+	if role.Slug != "editor" {
+		t.Fatalf("expected editor, got %s", role.Slug)
+	}
+	if len(afters) != 2 {
+		t.Fatalf("expected the scan to stop after 2 pages, got cursors %q", afters)
+	}
+
+	afters = nil
+	_, err = workosClient.GetOrganizationRoleByID(context.Background(), "org_123", "role_missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if len(afters) != 3 {
+		t.Fatalf("expected every page to be scanned, got cursors %q", afters)
+	}
+}
@@ -22,6 +22,12 @@ func (c *Client) CreateOrganization(ctx context.Context, req *OrganizationCreate
 
 // GetOrganization retrieves an organization by ID
 func (c *Client) GetOrganization(ctx context.Context, id string) (*Organization, error) {
+	if c.snapshots != nil {
+		if org, ok := c.snapshotOrganization(ctx, id); ok {
+			return org, nil
+		}
+	}
+
 	var org Organization
 	err := c.Get(ctx, "/organizations/"+url.PathEscape(id), &org)
 	if err != nil {
@@ -83,6 +89,43 @@ func (c *Client) GetOrganizationByExternalID(ctx context.Context, externalID str
 	return &org, nil
 }
 
+// GetOrganizationByName finds a single organization by exact name.
+// Returns an error if no organizations or multiple organizations have the name.
+func (c *Client) GetOrganizationByName(ctx context.Context, name string) (*Organization, error) {
+	resp, err := c.ListOrganizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []Organization
+	for _, org := range resp.Data {
+		if org.Name == name {
+			matches = append(matches, org)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, &APIError{
+			StatusCode: 404,
+			Message:    fmt.Sprintf("no organization found with name: %s", name),
+		}
+	}
+
+	if len(matches) > 1 {
+		orgIDs := make([]string, len(matches))
+		for i, org := range matches {
+			orgIDs[i] = org.ID
+		}
+		return nil, fmt.Errorf(
+			"ambiguous name lookup: %d organizations are named %q: [%s]. "+
+				"Use the organization ID to look up a specific organization instead",
+			len(matches), name, strings.Join(orgIDs, ", "),
+		)
+	}
+
+	return &matches[0], nil
+}
+
 // ListOrganizationsByDomain returns all organizations matching a given domain
 func (c *Client) ListOrganizationsByDomain(ctx context.Context, domain string) ([]Organization, error) {
 	var orgs []Organization
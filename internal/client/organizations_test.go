@@ -0,0 +1,85 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newOrganizationNameServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations" {
+			t.Fatalf("expected /organizations, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("after") == "" {
+			_, _ = w.Write([]byte(`{"data":[{"id":"org_1","name":"Acme"},{"id":"org_2","name":"Globex"}],"list_metadata":{"after":"org_2"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"org_3","name":"Initech"},{"id":"org_4","name":"Globex"},{"id":"org_5","name":"acme"}],"list_metadata":{}}`))
+	}))
+}
+
+func TestOrganizationsClientGetByNameExactMatch(t *testing.T) {
+	server := newOrganizationNameServer(t)
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	org, err := client.GetOrganizationByName(context.Background(), "Initech")
+	if err != nil {
+		t.Fatalf("GetOrganizationByName returned error: %v", err)
+	}
+	if org.ID != "org_3" {
+		t.Fatalf("expected org_3 from the second page, got %s", org.ID)
+	}
+
+	org, err = client.GetOrganizationByName(context.Background(), "Acme")
+	if err != nil {
+		t.Fatalf("GetOrganizationByName returned error: %v", err)
+	}
+	if org.ID != "org_1" {
+		t.Fatalf("expected case-sensitive match org_1, got %s", org.ID)
+	}
+}
+
+func TestOrganizationsClientGetByNameAmbiguous(t *testing.T) {
+	server := newOrganizationNameServer(t)
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	_, err = client.GetOrganizationByName(context.Background(), "Globex")
+	if err == nil || !strings.Contains(err.Error(), "org_2, org_4") {
+		t.Fatalf("expected ambiguity error listing both organizations, got %v", err)
+	}
+}
+
+func TestOrganizationsClientGetByNameNotFound(t *testing.T) {
+	server := newOrganizationNameServer(t)
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	_, err = client.GetOrganizationByName(context.Background(), "Umbrella")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
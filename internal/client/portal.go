@@ -0,0 +1,42 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// PortalLinkRequest represents the request to generate an Admin Portal link.
+type PortalLinkRequest struct {
+	Organization string `json:"organization"`
+	Intent       string `json:"intent"`
+	ReturnURL    string `json:"return_url,omitempty"`
+	SuccessURL   string `json:"success_url,omitempty"`
+}
+
+// PortalLinkResponse represents the response from generating an Admin Portal link.
+type PortalLinkResponse struct {
+	Link string `json:"link"`
+}
+
+// PortalIntents lists the Admin Portal intents accepted when generating a link.
+var PortalIntents = []string{
+	"sso",
+	"dsync",
+	"audit_logs",
+	"log_streams",
+	"domain_verification",
+	"certificate_renewal",
+}
+
+// GeneratePortalLink generates a short-lived Admin Portal link for an organization.
+func (c *Client) GeneratePortalLink(ctx context.Context, req *PortalLinkRequest) (string, error) {
+	var resp PortalLinkResponse
+	err := c.Post(ctx, "/portal/generate_link", req, &resp)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate portal link: %w", err)
+	}
+	return resp.Link, nil
+}
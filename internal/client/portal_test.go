@@ -0,0 +1,55 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPortalClientGenerateLink(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/portal/generate_link" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if body["organization"] != "org_123" || body["intent"] != "sso" || body["return_url"] != "https://app.example.com/settings" {
+			t.Fatalf("unexpected request body: %#v", body)
+		}
+		if _, exists := body["success_url"]; exists {
+			t.Fatalf("expected success_url to be omitted, got %#v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"link":"https://setup.workos.com/portal/launch?secret=abc"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	link, err := client.GeneratePortalLink(context.Background(), &PortalLinkRequest{
+		Organization: "org_123",
+		Intent:       "sso",
+		ReturnURL:    "https://app.example.com/settings",
+	})
+	if err != nil {
+		t.Fatalf("GeneratePortalLink returned error: %v", err)
+	}
+	if link != "https://setup.workos.com/portal/launch?secret=abc" {
+		t.Fatalf("unexpected link: %s", link)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// readSnapshots serves individual reads from a single list call per object
+// type when batch reads are enabled. A refresh of a large state then costs one
+// paginated list per type instead of one GET per resource.
+//
+// Snapshots are dropped whenever the client sends a write, so reads that
+// follow a change made by this provider never see stale data. Until then a
+// snapshot is served as listed: a change made outside Terraform after the list
+// call is not seen until the next write or the next run.
+type readSnapshots struct {
+	mu            sync.Mutex
+	users         *readSnapshot[User]
+	organizations *readSnapshot[Organization]
+	connections   *readSnapshot[Connection]
+	// memberships are keyed by organization ID, as the API cannot list every
+	// membership in the environment at once.
+	memberships map[string]*readSnapshot[OrganizationMembership]
+}
+
+// readSnapshot holds every object of one type, keyed by ID.
+type readSnapshot[T any] struct {
+	mu     sync.Mutex
+	loaded bool
+	items  map[string]T
+}
+
+// lookup returns the object with the given ID, listing every object with load
+// on first use. ok is false when the object is not in the snapshot or the list
+// failed, in which case the caller reads the object directly.
+//
+// The snapshot is shared by every caller, so load runs with a context that is
+// not cancelled with the caller's, and a failed list is retried by the next
+// lookup rather than remembered.
+func (s *readSnapshot[T]) lookup(ctx context.Context, id string, load func(context.Context) (map[string]T, error)) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.loaded {
+		items, err := load(context.WithoutCancel(ctx))
+		if err == nil {
+			s.items = items
+			s.loaded = true
+		}
+	}
+
+	item, ok := s.items[id]
+	return item, ok
+}
+
+// EnableBatchReads makes the client serve reads of users, organizations,
+// connections and organization memberships from list snapshots.
+func (c *Client) EnableBatchReads() {
+	c.snapshots = &readSnapshots{}
+}
+
+// resetSnapshots drops every snapshot so later reads list again.
+func (c *Client) resetSnapshots() {
+	if c.snapshots == nil {
+		return
+	}
+
+	c.snapshots.mu.Lock()
+	defer c.snapshots.mu.Unlock()
+	c.snapshots.users = nil
+	c.snapshots.organizations = nil
+	c.snapshots.connections = nil
+	c.snapshots.memberships = nil
+}
+
+func (c *Client) snapshotUser(ctx context.Context, id string) (*User, bool) {
+	c.snapshots.mu.Lock()
+	if c.snapshots.users == nil {
+		c.snapshots.users = &readSnapshot[User]{}
+	}
+	snapshot := c.snapshots.users
+	c.snapshots.mu.Unlock()
+
+	user, ok := snapshot.lookup(ctx, id, func(ctx context.Context) (map[string]User, error) {
+		list, err := c.ListUsers(ctx, "", "")
+		if err != nil {
+			return nil, err
+		}
+		items := make(map[string]User, len(list.Data))
+		for _, item := range list.Data {
+			items[item.ID] = item
+		}
+		return items, nil
+	})
+	return &user, ok
+}
+
+func (c *Client) snapshotOrganization(ctx context.Context, id string) (*Organization, bool) {
+	c.snapshots.mu.Lock()
+	if c.snapshots.organizations == nil {
+		c.snapshots.organizations = &readSnapshot[Organization]{}
+	}
+	snapshot := c.snapshots.organizations
+	c.snapshots.mu.Unlock()
+
+	org, ok := snapshot.lookup(ctx, id, func(ctx context.Context) (map[string]Organization, error) {
+		list, err := c.ListOrganizations(ctx)
+		if err != nil {
+			return nil, err
+		}
+		items := make(map[string]Organization, len(list.Data))
+		for _, item := range list.Data {
+			items[item.ID] = item
+		}
+		return items, nil
+	})
+	return &org, ok
+}
+
+func (c *Client) snapshotConnection(ctx context.Context, id string) (*Connection, bool) {
+	c.snapshots.mu.Lock()
+	if c.snapshots.connections == nil {
+		c.snapshots.connections = &readSnapshot[Connection]{}
+	}
+	snapshot := c.snapshots.connections
+	c.snapshots.mu.Unlock()
+
+	conn, ok := snapshot.lookup(ctx, id, func(ctx context.Context) (map[string]Connection, error) {
+		list, err := c.ListConnections(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		items := make(map[string]Connection, len(list.Data))
+		for _, item := range list.Data {
+			items[item.ID] = item
+		}
+		return items, nil
+	})
+	return &conn, ok
+}
+
+func (c *Client) snapshotOrganizationMembership(ctx context.Context, organizationID, id string) (*OrganizationMembership, bool) {
+	c.snapshots.mu.Lock()
+	if c.snapshots.memberships == nil {
+		c.snapshots.memberships = make(map[string]*readSnapshot[OrganizationMembership])
+	}
+	snapshot, exists := c.snapshots.memberships[organizationID]
+	if !exists {
+		snapshot = &readSnapshot[OrganizationMembership]{}
+		c.snapshots.memberships[organizationID] = snapshot
+	}
+	c.snapshots.mu.Unlock()
+
+	membership, ok := snapshot.lookup(ctx, id, func(ctx context.Context) (map[string]OrganizationMembership, error) {
+		list, err := c.ListOrganizationMemberships(ctx, "", organizationID)
+		if err != nil {
+			return nil, err
+		}
+		items := make(map[string]OrganizationMembership, len(list.Data))
+		for _, item := range list.Data {
+			items[item.ID] = item
+		}
+		return items, nil
+	})
+	return &membership, ok
+}
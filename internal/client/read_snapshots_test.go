@@ -0,0 +1,199 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientBatchReadsServeUsersFromList(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /user_management/users":
+			_, _ = w.Write([]byte(`{"data":[{"id":"user_1","email":"a@example.com"},{"id":"user_2","email":"b@example.com"}],"list_metadata":{}}`))
+		case "GET /user_management/users/user_3":
+			_, _ = w.Write([]byte(`{"id":"user_3","email":"c@example.com"}`))
+		case "PUT /user_management/users/user_1":
+			_, _ = w.Write([]byte(`{"id":"user_1","email":"a2@example.com"}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.EnableBatchReads()
+
+	ctx := context.Background()
+	for _, id := range []string{"user_1", "user_2", "user_3"} {
+		user, err := client.GetUser(ctx, id)
+		if err != nil {
+			t.Fatalf("GetUser(%s) returned error: %v", id, err)
+		}
+		if user.ID != id {
+			t.Fatalf("expected %s, got %s", id, user.ID)
+		}
+	}
+	if _, err := client.UpdateUser(ctx, "user_1", &UserUpdateRequest{Email: "a2@example.com"}); err != nil {
+		t.Fatalf("UpdateUser returned error: %v", err)
+	}
+	if _, err := client.GetUser(ctx, "user_1"); err != nil {
+		t.Fatalf("GetUser returned error: %v", err)
+	}
+
+	expected := []string{
+		"GET /user_management/users",
+		"GET /user_management/users/user_3",
+		"PUT /user_management/users/user_1",
+		"GET /user_management/users",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestClientBatchReadsServeMembershipsPerOrganization(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/user_management/organization_memberships" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		org := r.URL.Query().Get("organization_id")
+		_, _ = fmt.Fprintf(w, `{"data":[{"id":"om_%[1]s_1","organization_id":"%[1]s"},{"id":"om_%[1]s_2","organization_id":"%[1]s"}],"list_metadata":{}}`, org)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.EnableBatchReads()
+
+	ctx := context.Background()
+	for _, tc := range [][2]string{{"org_a", "om_org_a_1"}, {"org_a", "om_org_a_2"}, {"org_b", "om_org_b_1"}} {
+		membership, err := client.GetOrganizationMembershipInOrganization(ctx, tc[0], tc[1])
+		if err != nil {
+			t.Fatalf("GetOrganizationMembershipInOrganization returned error: %v", err)
+		}
+		if membership.ID != tc[1] {
+			t.Fatalf("expected %s, got %s", tc[1], membership.ID)
+		}
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected one list per organization, got %v", requests)
+	}
+}
+
+func TestClientReadsDirectlyWithoutBatchReads(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"org_123","name":"Acme"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, err := client.GetOrganization(context.Background(), "org_123"); err != nil {
+		t.Fatalf("GetOrganization returned error: %v", err)
+	}
+	if len(requests) != 1 || requests[0] != "GET /organizations/org_123" {
+		t.Fatalf("expected a direct read, got %v", requests)
+	}
+}
+
+func TestClientBatchReadsRetryFailedList(t *testing.T) {
+	var requests []string
+	listCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /organizations":
+			listCalls++
+			if listCalls == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"message":"Internal server error"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[{"id":"org_1","name":"Acme"},{"id":"org_2","name":"Globex"}],"list_metadata":{}}`))
+		case "GET /organizations/org_1":
+			_, _ = w.Write([]byte(`{"id":"org_1","name":"Acme"}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.EnableBatchReads()
+
+	ctx := context.Background()
+	for _, id := range []string{"org_1", "org_2"} {
+		org, err := client.GetOrganization(ctx, id)
+		if err != nil {
+			t.Fatalf("GetOrganization(%s) returned error: %v", id, err)
+		}
+		if org.ID != id {
+			t.Fatalf("expected %s, got %s", id, org.ID)
+		}
+	}
+
+	expected := []string{
+		"GET /organizations",
+		"GET /organizations/org_1",
+		"GET /organizations",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestClientBatchReadsIgnoreFirstCallerCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /user_management/users":
+			_, _ = w.Write([]byte(`{"data":[{"id":"user_1","email":"a@example.com"}],"list_metadata":{}}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.EnableBatchReads()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if user, ok := client.snapshotUser(ctx, "user_1"); !ok || user.ID != "user_1" {
+		t.Fatalf("expected the snapshot to load despite the cancelled caller, got %v %v", user, ok)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Session represents an AuthKit session belonging to a user.
+type Session struct {
+	ID             string     `json:"id"`
+	Object         string     `json:"object"`
+	UserID         string     `json:"user_id"`
+	OrganizationID string     `json:"organization_id,omitempty"`
+	Status         string     `json:"status"`
+	AuthMethod     string     `json:"auth_method,omitempty"`
+	IPAddress      string     `json:"ip_address,omitempty"`
+	UserAgent      string     `json:"user_agent,omitempty"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	EndedAt        *time.Time `json:"ended_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// SessionListResponse represents the response from listing a user's sessions.
+type SessionListResponse struct {
+	Data         []Session    `json:"data"`
+	ListMetadata ListMetadata `json:"list_metadata"`
+}
+
+// SessionRevokeRequest represents the request to revoke a session.
+type SessionRevokeRequest struct {
+	SessionID string `json:"session_id"`
+}
+
+// ListUserSessions lists all sessions for a user.
+func (c *Client) ListUserSessions(ctx context.Context, userID string) (*SessionListResponse, error) {
+	var all SessionListResponse
+	params := url.Values{}
+	applyDefaultPagination(params)
+	path := fmt.Sprintf("/user_management/users/%s/sessions", url.PathEscape(userID))
+
+	for {
+		var page SessionListResponse
+		err := c.Get(ctx, pathWithQuery(path, params), &page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sessions: %w", err)
+		}
+
+		all.Data = append(all.Data, page.Data...)
+		all.ListMetadata = page.ListMetadata
+		if page.ListMetadata.After == "" {
+			break
+		}
+		params.Set("after", page.ListMetadata.After)
+	}
+
+	return &all, nil
+}
+
+// RevokeSession revokes a session.
+func (c *Client) RevokeSession(ctx context.Context, sessionID string) error {
+	err := c.Post(ctx, "/user_management/sessions/revoke", &SessionRevokeRequest{SessionID: sessionID}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSessionsClientListUserSessions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/user_management/users/user_123/sessions" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"session_1","user_id":"user_123","organization_id":"org_123","status":"active","created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"}],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	sessions, err := client.ListUserSessions(context.Background(), "user_123")
+	if err != nil {
+		t.Fatalf("ListUserSessions returned error: %v", err)
+	}
+	if len(sessions.Data) != 1 || sessions.Data[0].OrganizationID != "org_123" {
+		t.Fatalf("unexpected sessions: %#v", sessions.Data)
+	}
+}
+
+func TestSessionsClientRevokeSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/user_management/sessions/revoke" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		var body SessionRevokeRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if body.SessionID != "session_1" {
+			t.Fatalf("unexpected session_id %q", body.SessionID)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if err := client.RevokeSession(context.Background(), "session_1"); err != nil {
+		t.Fatalf("RevokeSession returned error: %v", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 // UserListResponse represents the response from listing users
@@ -33,6 +34,12 @@ func (c *Client) CreateUser(ctx context.Context, req *UserCreateRequest) (*User,
 
 // GetUser retrieves a user by ID
 func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
+	if c.snapshots != nil {
+		if user, ok := c.snapshotUser(ctx, id); ok {
+			return user, nil
+		}
+	}
+
 	var user User
 	err := c.Get(ctx, "/user_management/users/"+url.PathEscape(id), &user)
 	if err != nil {
@@ -51,6 +58,29 @@ func (c *Client) UpdateUser(ctx context.Context, id string, req *UserUpdateReque
 	return &user, nil
 }
 
+// AnonymizeUser clears the names and metadata of a user
+func (c *Client) AnonymizeUser(ctx context.Context, id string, req *UserAnonymizeRequest) (*User, error) {
+	var user User
+	err := c.Put(ctx, "/user_management/users/"+url.PathEscape(id), req, &user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to anonymize user: %w", err)
+	}
+	return &user, nil
+}
+
+// SendVerificationEmail sends an email verification code to the user's current
+// email address
+func (c *Client) SendVerificationEmail(ctx context.Context, id string) (*User, error) {
+	var resp struct {
+		User User `json:"user"`
+	}
+	err := c.Post(ctx, "/user_management/users/"+url.PathEscape(id)+"/email_verification/send", nil, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send verification email: %w", err)
+	}
+	return &resp.User, nil
+}
+
 // DeleteUser deletes a user by ID
 func (c *Client) DeleteUser(ctx context.Context, id string) error {
 	err := c.Delete(ctx, "/user_management/users/"+url.PathEscape(id))
@@ -100,16 +130,43 @@ func (c *Client) GetUserByExternalID(ctx context.Context, externalID string) (*U
 	return &user, nil
 }
 
-// GetUserByEmail retrieves a user by email
+// GetUserByEmail finds a single user by email address, compared
+// case-insensitively. The API's email filter is not relied on for an exact
+// match, so every page of results is checked. Returns an error if no users or
+// multiple users have the address.
 func (c *Client) GetUserByEmail(ctx context.Context, email string) (*User, error) {
 	resp, err := c.ListUsers(ctx, email, "")
 	if err != nil {
 		return nil, err
 	}
-	if len(resp.Data) == 0 {
-		return nil, &APIError{StatusCode: 404, Message: "user not found"}
+
+	var matches []User
+	for _, user := range resp.Data {
+		if strings.EqualFold(user.Email, email) {
+			matches = append(matches, user)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, &APIError{
+			StatusCode: 404,
+			Message:    fmt.Sprintf("no user found with email: %s", email),
+		}
+	}
+
+	if len(matches) > 1 {
+		userIDs := make([]string, len(matches))
+		for i, user := range matches {
+			userIDs[i] = user.ID
+		}
+		return nil, fmt.Errorf(
+			"ambiguous email lookup: %d users have the email %q: [%s]. "+
+				"Use the user ID to look up a specific user instead",
+			len(matches), email, strings.Join(userIDs, ", "),
+		)
 	}
-	return &resp.Data[0], nil
+
+	return &matches[0], nil
 }
 
 // CreateOrganizationMembership creates a new organization membership
@@ -132,6 +189,18 @@ func (c *Client) GetOrganizationMembership(ctx context.Context, id string) (*Org
 	return &membership, nil
 }
 
+// GetOrganizationMembershipInOrganization retrieves a membership by ID. The
+// organization ID lets batch reads serve it from the organization's list of
+// memberships.
+func (c *Client) GetOrganizationMembershipInOrganization(ctx context.Context, organizationID, id string) (*OrganizationMembership, error) {
+	if c.snapshots != nil && organizationID != "" {
+		if membership, ok := c.snapshotOrganizationMembership(ctx, organizationID, id); ok {
+			return membership, nil
+		}
+	}
+	return c.GetOrganizationMembership(ctx, id)
+}
+
 // UpdateOrganizationMembership updates an organization membership by ID
 func (c *Client) UpdateOrganizationMembership(ctx context.Context, id string, req *OrganizationMembershipUpdateRequest) (*OrganizationMembership, error) {
 	var membership OrganizationMembership
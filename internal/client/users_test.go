@@ -0,0 +1,76 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetUserByEmail(t *testing.T) {
+	testCases := map[string]struct {
+		pages       []string
+		expectID    string
+		expectError string
+	}{
+		"exact match on a later page": {
+			pages: []string{
+				`{"data":[{"id":"user_1","email":"jane.doe@example.com"}],"list_metadata":{"after":"user_1"}}`,
+				`{"data":[{"id":"user_2","email":"Jane@Example.com"}],"list_metadata":{}}`,
+			},
+			expectID: "user_2",
+		},
+		"no exact match": {
+			pages:       []string{`{"data":[{"id":"user_1","email":"jane.doe@example.com"}],"list_metadata":{}}`},
+			expectError: "no user found",
+		},
+		"ambiguous": {
+			pages:       []string{`{"data":[{"id":"user_1","email":"jane@example.com"},{"id":"user_2","email":"JANE@example.com"}],"list_metadata":{}}`},
+			expectError: "ambiguous email lookup: 2 users",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/user_management/users" {
+					t.Fatalf("unexpected path %s", r.URL.Path)
+				}
+				if got := r.URL.Query().Get("email"); got != "jane@example.com" {
+					t.Fatalf("expected email=jane@example.com, got %q", got)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Query().Get("after") == "" {
+					_, _ = w.Write([]byte(tc.pages[0]))
+					return
+				}
+				_, _ = w.Write([]byte(tc.pages[1]))
+			}))
+			defer server.Close()
+
+			client, err := NewClient("sk_test", "", server.URL)
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			user, err := client.GetUserByEmail(context.Background(), "jane@example.com")
+			if tc.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectError) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetUserByEmail returned error: %v", err)
+			}
+			if user.ID != tc.expectID {
+				t.Fatalf("expected %s, got %s", tc.expectID, user.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// apiKeyCommandTimeout bounds how long a credential helper may run.
+const apiKeyCommandTimeout = 30 * time.Second
+
+// readAPIKeyFile reads an API key from a file, such as a Kubernetes secret or
+// a file rendered by a Vault agent. Surrounding whitespace is ignored.
+func readAPIKeyFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read API key file: %w", err)
+	}
+
+	apiKey := strings.TrimSpace(string(data))
+	if apiKey == "" {
+		return "", fmt.Errorf("API key file %s is empty", path)
+	}
+	return apiKey, nil
+}
+
+// runAPIKeyCommand runs a credential helper and returns its standard output
+// as the API key. The command is executed directly, without a shell.
+func runAPIKeyCommand(ctx context.Context, args []string) (string, error) {
+	if len(args) == 0 || args[0] == "" {
+		return "", fmt.Errorf("API key command must not be empty")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, apiKeyCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("API key command %s failed: %w: %s", args[0], err, msg)
+		}
+		return "", fmt.Errorf("API key command %s failed: %w", args[0], err)
+	}
+
+	apiKey := strings.TrimSpace(stdout.String())
+	if apiKey == "" {
+		return "", fmt.Errorf("API key command %s printed no API key", args[0])
+	}
+	return apiKey, nil
+}
+
+const (
+	environmentProduction = "production"
+	environmentSandbox    = "sandbox"
+)
+
+// apiKeyEnvironment reports the WorkOS environment an API key belongs to from
+// its prefix, or an empty string when the prefix is not recognised.
+func apiKeyEnvironment(apiKey string) string {
+	switch {
+	case strings.HasPrefix(apiKey, "sk_live_"):
+		return environmentProduction
+	case strings.HasPrefix(apiKey, "sk_test_"):
+		return environmentSandbox
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadAPIKeyFile(t *testing.T) {
+	dir := t.TempDir()
+
+	keyFile := filepath.Join(dir, "api_key")
+	if err := os.WriteFile(keyFile, []byte("sk_test_from_file\n"), 0o600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+	apiKey, err := readAPIKeyFile(keyFile)
+	if err != nil {
+		t.Fatalf("readAPIKeyFile returned error: %v", err)
+	}
+	if apiKey != "sk_test_from_file" {
+		t.Fatalf("unexpected API key: %q", apiKey)
+	}
+
+	emptyFile := filepath.Join(dir, "empty")
+	if err := os.WriteFile(emptyFile, []byte("  \n"), 0o600); err != nil {
+		t.Fatalf("failed to write empty file: %v", err)
+	}
+	if _, err := readAPIKeyFile(emptyFile); err == nil {
+		t.Fatal("expected an error for an empty key file")
+	}
+
+	if _, err := readAPIKeyFile(filepath.Join(dir, "missing")); err == nil {
+		t.Fatal("expected an error for a missing key file")
+	}
+}
+
+func TestRunAPIKeyCommand(t *testing.T) {
+	if _, err := os.Stat("/bin/sh"); err != nil {
+		t.Skip("requires /bin/sh")
+	}
+
+	testCases := map[string]struct {
+		args        []string
+		expected    string
+		expectError string
+	}{
+		"prints key": {
+			args:     []string{"/bin/sh", "-c", "echo sk_test_from_helper"},
+			expected: "sk_test_from_helper",
+		},
+		"fails": {
+			args:        []string{"/bin/sh", "-c", "echo vault sealed >&2; exit 3"},
+			expectError: "vault sealed",
+		},
+		"prints nothing": {
+			args:        []string{"/bin/sh", "-c", "true"},
+			expectError: "printed no API key",
+		},
+		"empty": {
+			args:        []string{},
+			expectError: "must not be empty",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			apiKey, err := runAPIKeyCommand(context.Background(), tc.args)
+			if tc.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectError) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("runAPIKeyCommand returned error: %v", err)
+			}
+			if apiKey != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, apiKey)
+			}
+		})
+	}
+}
+
+func TestAPIKeyEnvironment(t *testing.T) {
+	testCases := map[string]string{
+		"sk_live_abc123": environmentProduction,
+		"sk_test_abc123": environmentSandbox,
+		"sk_abc123":      "",
+		"":               "",
+	}
+
+	for apiKey, expected := range testCases {
+		if got := apiKeyEnvironment(apiKey); got != expected {
+			t.Fatalf("apiKeyEnvironment(%q): expected %q, got %q", apiKey, expected, got)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuditLogActionsDataSource{}
+
+func NewAuditLogActionsDataSource() datasource.DataSource {
+	return &AuditLogActionsDataSource{}
+}
+
+// AuditLogActionsDataSource defines the data source implementation.
+type AuditLogActionsDataSource struct {
+	client *client.Client
+}
+
+// AuditLogActionsDataSourceModel describes the data source data model.
+type AuditLogActionsDataSourceModel struct {
+	Names   []types.String                `tfsdk:"names"`
+	Actions []AuditLogActionListItemModel `tfsdk:"actions"`
+}
+
+// AuditLogActionListItemModel describes a single registered audit log action.
+type AuditLogActionListItemModel struct {
+	Name          types.String   `tfsdk:"name"`
+	SchemaVersion types.Int64    `tfsdk:"schema_version"`
+	TargetTypes   []types.String `tfsdk:"target_types"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	UpdatedAt     types.String   `tfsdk:"updated_at"`
+}
+
+func (d *AuditLogActionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log_actions"
+}
+
+func (d *AuditLogActionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the audit log actions registered in the WorkOS environment.",
+		MarkdownDescription: `
+Use this data source to list the audit log actions registered in the WorkOS environment.
+
+This is useful for checking that the actions referenced by alerting rules or
+` + "`workos_audit_log_event`" + ` resources have a schema before they are used.
+
+## Example Usage
+
+` + "```hcl" + `
+data "workos_audit_log_actions" "all" {}
+
+check "audit_log_actions_registered" {
+  assert {
+    condition     = contains(data.workos_audit_log_actions.all.names, "user.signed_in")
+    error_message = "The user.signed_in audit log action has no schema in this environment."
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Description:         "The names of the registered audit log actions.",
+				MarkdownDescription: "The names of the registered audit log actions (e.g., `user.signed_in`).",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"actions": schema.ListNestedAttribute{
+				Description:         "The registered audit log actions and their latest schema.",
+				MarkdownDescription: "The registered audit log actions and their latest schema.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the action.",
+							Computed:    true,
+						},
+						"schema_version": schema.Int64Attribute{
+							Description: "The version of the action's latest schema.",
+							Computed:    true,
+						},
+						"target_types": schema.ListAttribute{
+							Description: "The target types accepted by the action's latest schema.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp when the action was registered.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The timestamp when the action was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditLogActionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuditLogActionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
+	var config AuditLogActionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing audit log actions")
+
+	actions, err := d.client.ListAuditLogActions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Audit Log Actions",
+			"Could not list audit log actions: "+err.Error(),
+		)
+		return
+	}
+
+	config.Names = make([]types.String, 0, len(actions.Data))
+	config.Actions = make([]AuditLogActionListItemModel, 0, len(actions.Data))
+	for _, action := range actions.Data {
+		targetTypes := make([]types.String, 0, len(action.Schema.Targets))
+		for _, target := range action.Schema.Targets {
+			targetTypes = append(targetTypes, types.StringValue(target.Type))
+		}
+
+		config.Names = append(config.Names, types.StringValue(action.Name))
+		config.Actions = append(config.Actions, AuditLogActionListItemModel{
+			Name:          types.StringValue(action.Name),
+			SchemaVersion: types.Int64Value(action.Schema.Version),
+			TargetTypes:   targetTypes,
+			CreatedAt:     types.StringValue(action.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:     types.StringValue(action.UpdatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	tflog.Info(ctx, "Read audit log actions", map[string]any{
+		"count": len(config.Actions),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
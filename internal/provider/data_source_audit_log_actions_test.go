@@ -0,0 +1,95 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestAuditLogActionsDataSource_ListsActions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/audit_logs/actions" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[
+  {"object":"audit_log_action","name":"user.signed_in","schema":{"object":"audit_log_schema","version":2,"targets":[{"type":"user"},{"type":"team"}]},"created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-16T12:00:00.000Z"},
+  {"object":"audit_log_action","name":"team.deleted","schema":{"object":"audit_log_schema","version":1,"targets":[]},"created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"}
+],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	state := readAuditLogActionsDataSource(t, server.URL)
+
+	if len(state.Names) != 2 || state.Names[0].ValueString() != "user.signed_in" || state.Names[1].ValueString() != "team.deleted" {
+		t.Fatalf("unexpected names: %v", state.Names)
+	}
+	action := state.Actions[0]
+	if action.SchemaVersion.ValueInt64() != 2 {
+		t.Fatalf("unexpected schema version: %d", action.SchemaVersion.ValueInt64())
+	}
+	if len(action.TargetTypes) != 2 || action.TargetTypes[1].ValueString() != "team" {
+		t.Fatalf("unexpected target types: %v", action.TargetTypes)
+	}
+	if action.UpdatedAt.ValueString() != "2026-01-16T12:00:00Z" {
+		t.Fatalf("unexpected updated_at: %s", action.UpdatedAt.ValueString())
+	}
+	if state.Actions[1].TargetTypes == nil || len(state.Actions[1].TargetTypes) != 0 {
+		t.Fatalf("expected empty target types, got %v", state.Actions[1].TargetTypes)
+	}
+}
+
+func readAuditLogActionsDataSource(t *testing.T, baseURL string) AuditLogActionsDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &AuditLogActionsDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &AuditLogActionsDataSourceModel{})
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state AuditLogActionsDataSourceModel
+	diags = readResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+
+	return state
+}
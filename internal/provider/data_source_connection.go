@@ -38,6 +38,9 @@ type ConnectionDataSourceModel struct {
 	Name           types.String `tfsdk:"name"`
 	State          types.String `tfsdk:"state"`
 	Status         types.String `tfsdk:"status"`
+	ACSURL         types.String `tfsdk:"acs_url"`
+	SPEntityID     types.String `tfsdk:"sp_entity_id"`
+	SPMetadataURL  types.String `tfsdk:"sp_metadata_url"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
 }
@@ -107,6 +110,21 @@ data "workos_connection" "okta" {
 				MarkdownDescription: "The configuration status of the connection (`linked`, `unlinked`).",
 				Computed:            true,
 			},
+			"acs_url": schema.StringAttribute{
+				Description:         "The service provider Assertion Consumer Service URL for SAML connections.",
+				MarkdownDescription: "The service provider Assertion Consumer Service (ACS) URL to configure in the identity provider. Null for non-SAML connections.",
+				Computed:            true,
+			},
+			"sp_entity_id": schema.StringAttribute{
+				Description:         "The service provider entity ID for SAML connections.",
+				MarkdownDescription: "The service provider entity ID (audience URI) to configure in the identity provider. Null for non-SAML connections.",
+				Computed:            true,
+			},
+			"sp_metadata_url": schema.StringAttribute{
+				Description:         "The service provider metadata URL for SAML connections.",
+				MarkdownDescription: "The service provider metadata URL, for identity providers that can import SAML metadata. Null for non-SAML connections or when WorkOS does not return one.",
+				Computed:            true,
+			},
 			"created_at": schema.StringAttribute{
 				Description:         "The timestamp when the connection was created.",
 				MarkdownDescription: "The timestamp when the connection was created (RFC3339 format).",
@@ -152,6 +170,8 @@ func (d *ConnectionDataSource) Configure(ctx context.Context, req datasource.Con
 }
 
 func (d *ConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
 	var config ConnectionDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -207,6 +227,14 @@ func (d *ConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	config.Name = types.StringValue(conn.Name)
 	config.State = types.StringValue(conn.State)
 	config.Status = types.StringValue(conn.Status)
+	config.ACSURL = types.StringNull()
+	config.SPEntityID = types.StringNull()
+	config.SPMetadataURL = types.StringNull()
+	if conn.SAMLConfiguration != nil {
+		config.ACSURL = optionalString(&conn.SAMLConfiguration.SPACSURL)
+		config.SPEntityID = optionalString(&conn.SAMLConfiguration.SPEntityID)
+		config.SPMetadataURL = optionalString(&conn.SAMLConfiguration.SPMetadataURL)
+	}
 	config.CreatedAt = types.StringValue(conn.CreatedAt.Format(time.RFC3339))
 	config.UpdatedAt = types.StringValue(conn.UpdatedAt.Format(time.RFC3339))
 
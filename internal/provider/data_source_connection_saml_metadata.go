@@ -0,0 +1,171 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConnectionSAMLMetadataDataSource{}
+
+func NewConnectionSAMLMetadataDataSource() datasource.DataSource {
+	return &ConnectionSAMLMetadataDataSource{}
+}
+
+// ConnectionSAMLMetadataDataSource defines the data source implementation.
+type ConnectionSAMLMetadataDataSource struct {
+	client *client.Client
+}
+
+// ConnectionSAMLMetadataDataSourceModel describes the data source data model.
+type ConnectionSAMLMetadataDataSourceModel struct {
+	ConnectionID types.String `tfsdk:"connection_id"`
+	MetadataURL  types.String `tfsdk:"metadata_url"`
+	MetadataXML  types.String `tfsdk:"metadata_xml"`
+	EntityID     types.String `tfsdk:"entity_id"`
+	ACSURL       types.String `tfsdk:"acs_url"`
+}
+
+func (d *ConnectionSAMLMetadataDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_saml_metadata"
+}
+
+func (d *ConnectionSAMLMetadataDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to read the service provider metadata XML of a WorkOS SAML connection.",
+		MarkdownDescription: `
+Use this data source to read the service provider metadata XML of a WorkOS SAML
+connection.
+
+Identity providers managed by other Terraform providers can import the document
+in the same plan, instead of having the entity ID and ACS URL copied into them.
+
+## Example Usage
+
+` + "```hcl" + `
+data "workos_connection_saml_metadata" "acme" {
+  connection_id = data.workos_connection.acme.id
+}
+
+resource "keycloak_saml_client" "workos" {
+  realm_id  = keycloak_realm.acme.id
+  client_id = data.workos_connection_saml_metadata.acme.entity_id
+  # ...
+}
+
+resource "local_file" "workos_sp_metadata" {
+  filename = "${path.module}/workos-sp-metadata.xml"
+  content  = data.workos_connection_saml_metadata.acme.metadata_xml
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Description: "The ID of the SAML connection.",
+				Required:    true,
+			},
+			"metadata_url": schema.StringAttribute{
+				Description: "The public URL the metadata document is served from.",
+				Computed:    true,
+			},
+			"metadata_xml": schema.StringAttribute{
+				Description: "The service provider metadata document as WorkOS serves it.",
+				Computed:    true,
+			},
+			"entity_id": schema.StringAttribute{
+				Description:         "The service provider entity ID declared by the metadata document.",
+				MarkdownDescription: "The service provider entity ID declared by the metadata document's `EntityDescriptor`.",
+				Computed:            true,
+			},
+			"acs_url": schema.StringAttribute{
+				Description:         "The service provider Assertion Consumer Service URL of the connection.",
+				MarkdownDescription: "The service provider Assertion Consumer Service (ACS) URL of the connection.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ConnectionSAMLMetadataDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConnectionSAMLMetadataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
+	var config ConnectionSAMLMetadataDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionID := config.ConnectionID.ValueString()
+
+	tflog.Debug(ctx, "Reading connection SAML metadata", map[string]any{
+		"connection_id": connectionID,
+	})
+
+	conn, err := d.client.GetConnection(ctx, connectionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Connection",
+			"Could not read connection ID "+connectionID+": "+err.Error(),
+		)
+		return
+	}
+
+	if conn.SAMLConfiguration == nil || conn.SAMLConfiguration.SPMetadataURL == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("connection_id"),
+			"Connection Has No SAML Metadata",
+			fmt.Sprintf("Connection %s (%s) has no service provider metadata URL. Only SAML connections publish service provider metadata.",
+				connectionID, conn.ConnectionType),
+		)
+		return
+	}
+
+	metadata, err := d.client.GetSAMLMetadata(ctx, conn.SAMLConfiguration.SPMetadataURL)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Connection SAML Metadata",
+			"Could not read the service provider metadata of connection "+connectionID+": "+err.Error(),
+		)
+		return
+	}
+
+	config.MetadataURL = types.StringValue(conn.SAMLConfiguration.SPMetadataURL)
+	config.MetadataXML = types.StringValue(metadata.XML)
+	config.EntityID = optionalString(&metadata.EntityID)
+	config.ACSURL = optionalString(&conn.SAMLConfiguration.SPACSURL)
+
+	tflog.Info(ctx, "Read connection SAML metadata", map[string]any{
+		"connection_id": connectionID,
+		"entity_id":     metadata.EntityID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+const testSAMLMetadata = `<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://auth.workos.com/conn_123">
+  <md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://auth.workos.com/sso/saml/acs/abc" index="0"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>`
+
+func TestConnectionSAMLMetadataDataSource(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/connections/conn_123":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"id":"conn_123","connection_type":"OktaSAML","saml":{"sp_acs_url":"https://auth.workos.com/sso/saml/acs/abc","sp_metadata_url":%q}}`,
+				server.URL+"/sso/saml/metadata/abc")
+		case "/connections/conn_google":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"conn_google","connection_type":"GoogleOAuth"}`))
+		case "/sso/saml/metadata/abc":
+			if r.Header.Get("Authorization") != "" {
+				t.Fatalf("expected the metadata to be requested without the API key")
+			}
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write([]byte(testSAMLMetadata))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	state, diags := readConnectionSAMLMetadataDataSource(t, server.URL, "conn_123")
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if state.MetadataXML.ValueString() != testSAMLMetadata {
+		t.Fatalf("unexpected metadata_xml: %s", state.MetadataXML)
+	}
+	if state.EntityID.ValueString() != "https://auth.workos.com/conn_123" {
+		t.Fatalf("unexpected entity_id: %s", state.EntityID)
+	}
+	if state.MetadataURL.ValueString() != server.URL+"/sso/saml/metadata/abc" {
+		t.Fatalf("unexpected metadata_url: %s", state.MetadataURL)
+	}
+	if state.ACSURL.ValueString() != "https://auth.workos.com/sso/saml/acs/abc" {
+		t.Fatalf("unexpected acs_url: %s", state.ACSURL)
+	}
+
+	_, diags = readConnectionSAMLMetadataDataSource(t, server.URL, "conn_google")
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "Only SAML connections") {
+		t.Fatalf("expected an error for a non-SAML connection, got %v", diags)
+	}
+}
+
+func readConnectionSAMLMetadataDataSource(t *testing.T, baseURL, connectionID string) (ConnectionSAMLMetadataDataSourceModel, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &ConnectionSAMLMetadataDataSource{client: workosClient}
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &ConnectionSAMLMetadataDataSourceModel{ConnectionID: types.StringValue(connectionID)})
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Raw: configState.Raw, Schema: schemaResp.Schema},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		return ConnectionSAMLMetadataDataSourceModel{}, readResp.Diagnostics
+	}
+
+	var state ConnectionSAMLMetadataDataSourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &state)...)
+	return state, readResp.Diagnostics
+}
@@ -0,0 +1,132 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestConnectionDataSource_SAMLServiceProviderDetails(t *testing.T) {
+	server := httptest.NewServer(connectionDataSourceHandler(t, `{
+  "id": "conn_123",
+  "organization_id": "org_123",
+  "connection_type": "OktaSAML",
+  "name": "Okta",
+  "state": "active",
+  "saml": {
+    "sp_entity_id": "https://auth.workos.com/conn_123",
+    "sp_acs_url": "https://auth.workos.com/sso/saml/acs/abc",
+    "sp_metadata_url": "https://auth.workos.com/sso/saml/metadata/abc"
+  }
+}`))
+	defer server.Close()
+
+	state := readConnectionDataSource(t, server.URL)
+
+	if state.ACSURL.ValueString() != "https://auth.workos.com/sso/saml/acs/abc" {
+		t.Fatalf("unexpected acs_url: %s", state.ACSURL)
+	}
+	if state.SPEntityID.ValueString() != "https://auth.workos.com/conn_123" {
+		t.Fatalf("unexpected sp_entity_id: %s", state.SPEntityID)
+	}
+	if state.SPMetadataURL.ValueString() != "https://auth.workos.com/sso/saml/metadata/abc" {
+		t.Fatalf("unexpected sp_metadata_url: %s", state.SPMetadataURL)
+	}
+}
+
+func TestConnectionDataSource_NonSAMLConnection(t *testing.T) {
+	server := httptest.NewServer(connectionDataSourceHandler(t, `{
+  "id": "conn_123",
+  "organization_id": "org_123",
+  "connection_type": "GoogleOAuth",
+  "name": "Google",
+  "state": "active"
+}`))
+	defer server.Close()
+
+	state := readConnectionDataSource(t, server.URL)
+
+	if !state.ACSURL.IsNull() || !state.SPEntityID.IsNull() || !state.SPMetadataURL.IsNull() {
+		t.Fatalf("expected null SAML attributes, got %s / %s / %s", state.ACSURL, state.SPEntityID, state.SPMetadataURL)
+	}
+}
+
+func connectionDataSourceHandler(t *testing.T, body string) http.Handler {
+	t.Helper()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/connections/conn_123" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+func readConnectionDataSource(t *testing.T, baseURL string) ConnectionDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &ConnectionDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &ConnectionDataSourceModel{
+		ID:             types.StringValue("conn_123"),
+		OrganizationID: types.StringNull(),
+		ConnectionType: types.StringNull(),
+		Name:           types.StringNull(),
+		State:          types.StringNull(),
+		Status:         types.StringNull(),
+		ACSURL:         types.StringNull(),
+		SPEntityID:     types.StringNull(),
+		SPMetadataURL:  types.StringNull(),
+		CreatedAt:      types.StringNull(),
+		UpdatedAt:      types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state ConnectionDataSourceModel
+	diags = readResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+
+	return state
+}
@@ -32,14 +32,16 @@ type DirectoryDataSource struct {
 
 // DirectoryDataSourceModel describes the data source data model.
 type DirectoryDataSourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	Name           types.String `tfsdk:"name"`
-	Type           types.String `tfsdk:"type"`
-	State          types.String `tfsdk:"state"`
-	Endpoint       types.String `tfsdk:"endpoint"`
-	CreatedAt      types.String `tfsdk:"created_at"`
-	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ID                 types.String `tfsdk:"id"`
+	OrganizationID     types.String `tfsdk:"organization_id"`
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	State              types.String `tfsdk:"state"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	IncludeBearerToken types.Bool   `tfsdk:"include_bearer_token"`
+	BearerToken        types.String `tfsdk:"bearer_token"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
 }
 
 func (d *DirectoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -52,7 +54,8 @@ func (d *DirectoryDataSource) Schema(ctx context.Context, req datasource.SchemaR
 		MarkdownDescription: `
 Use this data source to get information about a WorkOS Directory.
 
-You can look up a directory by its ID or by organization ID.
+You can look up a directory by its ID or by organization ID. When an organization has several
+directories, narrow the lookup with name and/or type.
 
 ## Example Usage
 
@@ -71,6 +74,15 @@ data "workos_directory" "example" {
   organization_id = workos_organization.main.id
 }
 ` + "```" + `
+
+### By Organization and Type
+
+` + "```hcl" + `
+data "workos_directory" "scim" {
+  organization_id = workos_organization.main.id
+  type            = "okta scim v2.0"
+}
+` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -86,13 +98,15 @@ data "workos_directory" "example" {
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				Description:         "The name of the directory.",
-				MarkdownDescription: "The name of the directory.",
+				Description:         "The name of the directory. Can be used with organization_id to select a directory.",
+				MarkdownDescription: "The name of the directory. Can be used with `organization_id` to select a directory by exact name.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"type": schema.StringAttribute{
-				Description:         "The type of directory.",
-				MarkdownDescription: "The type of directory (e.g., `okta scim v2.0`).",
+				Description:         "The type of directory. Can be used with organization_id to select a directory.",
+				MarkdownDescription: "The type of directory (e.g., `okta scim v2.0`). Can be used with `organization_id` to select a directory by type.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"state": schema.StringAttribute{
@@ -105,6 +119,17 @@ data "workos_directory" "example" {
 				MarkdownDescription: "The SCIM endpoint URL for this directory.",
 				Computed:            true,
 			},
+			"include_bearer_token": schema.BoolAttribute{
+				Description:         "Whether to fetch the directory's SCIM bearer token.",
+				MarkdownDescription: "Whether to fetch the directory's SCIM bearer token into `bearer_token`. Defaults to `false` so the secret is only stored in state when explicitly requested.",
+				Optional:            true,
+			},
+			"bearer_token": schema.StringAttribute{
+				Description:         "The SCIM bearer token for this directory. Only set when include_bearer_token is true.",
+				MarkdownDescription: "The SCIM bearer token for this directory. Only set when `include_bearer_token` is `true` and the API returns a token for the directory.",
+				Computed:            true,
+				Sensitive:           true,
+			},
 			"created_at": schema.StringAttribute{
 				Description:         "The timestamp when the directory was created.",
 				MarkdownDescription: "The timestamp when the directory was created (RFC3339 format).",
@@ -125,6 +150,14 @@ func (d *DirectoryDataSource) ConfigValidators(ctx context.Context) []datasource
 			path.MatchRoot("id"),
 			path.MatchRoot("organization_id"),
 		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("type"),
+		),
 	}
 }
 
@@ -146,6 +179,8 @@ func (d *DirectoryDataSource) Configure(ctx context.Context, req datasource.Conf
 }
 
 func (d *DirectoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
 	var config DirectoryDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -172,9 +207,11 @@ func (d *DirectoryDataSource) Read(ctx context.Context, req datasource.ReadReque
 	} else if !config.OrganizationID.IsNull() {
 		tflog.Debug(ctx, "Reading directory by organization", map[string]any{
 			"organization_id": config.OrganizationID.ValueString(),
+			"name":            config.Name.ValueString(),
+			"type":            config.Type.ValueString(),
 		})
 
-		dir, err = d.client.GetDirectoryByOrganization(ctx, config.OrganizationID.ValueString())
+		dir, err = d.client.GetDirectoryByOrganization(ctx, config.OrganizationID.ValueString(), config.Name.ValueString(), config.Type.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading Directory",
@@ -198,6 +235,29 @@ func (d *DirectoryDataSource) Read(ctx context.Context, req datasource.ReadReque
 	config.CreatedAt = types.StringValue(dir.CreatedAt.Format(time.RFC3339))
 	config.UpdatedAt = types.StringValue(dir.UpdatedAt.Format(time.RFC3339))
 
+	config.BearerToken = types.StringNull()
+	if config.IncludeBearerToken.ValueBool() {
+		// List responses omit secrets, so always fetch the single directory.
+		full, err := d.client.GetDirectory(ctx, dir.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Directory",
+				"Could not read bearer token for directory ID "+dir.ID+": "+err.Error(),
+			)
+			return
+		}
+
+		if full.BearerToken != "" {
+			config.BearerToken = types.StringValue(full.BearerToken)
+		} else {
+			resp.Diagnostics.AddWarning(
+				"Directory Bearer Token Unavailable",
+				"The WorkOS API did not return a bearer token for directory "+dir.ID+". "+
+					"Only SCIM directories have a bearer token, and it may need to be regenerated in the WorkOS Dashboard.",
+			)
+		}
+	}
+
 	tflog.Info(ctx, "Read directory", map[string]any{
 		"id":   dir.ID,
 		"type": dir.Type,
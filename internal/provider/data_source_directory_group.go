@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/osodevops/terraform-provider-workos/internal/client"
@@ -37,8 +39,10 @@ type DirectoryGroupDataSourceModel struct {
 	OrganizationID types.String `tfsdk:"organization_id"`
 	Name           types.String `tfsdk:"name"`
 	IdpID          types.String `tfsdk:"idp_id"`
+	RawAttributes  types.String `tfsdk:"raw_attributes"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
+	WaitForResults types.String `tfsdk:"wait_for_results"`
 }
 
 func (d *DirectoryGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -51,7 +55,9 @@ func (d *DirectoryGroupDataSource) Schema(ctx context.Context, req datasource.Sc
 		MarkdownDescription: `
 Use this data source to get information about a group synced from a WorkOS Directory.
 
-You can look up a group by ID or by directory ID and name.
+You can look up a group by ID, or by directory ID and either name or identity
+provider ID. Looking up by ` + "`idp_id`" + ` keeps working when the group is
+renamed in the identity provider.
 
 ## Example Usage
 
@@ -71,6 +77,37 @@ data "workos_directory_group" "engineering" {
   name         = "Engineering"
 }
 ` + "```" + `
+
+### By Directory and Identity Provider ID
+
+` + "```hcl" + `
+data "workos_directory_group" "engineering" {
+  directory_id = data.workos_directory.main.id
+  idp_id       = "00g1a2b3c4d5e6f7g8h9"
+}
+` + "```" + `
+
+### Reading IdP Attributes
+
+` + "```hcl" + `
+locals {
+  engineering_attributes = jsondecode(data.workos_directory_group.engineering.raw_attributes)
+}
+` + "```" + `
+
+### Waiting for the Initial Sync
+
+The initial sync of a new directory lags its creation. Set ` + "`wait_for_results`" + `
+to keep retrying a lookup while the group is not found, so it can be read in the
+same apply that sets the directory up.
+
+` + "```hcl" + `
+data "workos_directory_group" "admins" {
+  directory_id     = data.workos_directory.main.id
+  name             = "Admins"
+  wait_for_results = "10m"
+}
+` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -81,7 +118,7 @@ data "workos_directory_group" "engineering" {
 			},
 			"directory_id": schema.StringAttribute{
 				Description:         "The ID of the directory to search in.",
-				MarkdownDescription: "The ID of the directory to search in. Required when looking up by name.",
+				MarkdownDescription: "The ID of the directory to search in. Required when looking up by name or `idp_id`.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -92,13 +129,19 @@ data "workos_directory_group" "engineering" {
 			},
 			"name": schema.StringAttribute{
 				Description:         "The name of the group.",
-				MarkdownDescription: "The name of the group. Required when looking up by directory.",
+				MarkdownDescription: "The name of the group. Exactly one of `name` or `idp_id` is required when looking up by directory.",
 				Optional:            true,
 				Computed:            true,
 			},
 			"idp_id": schema.StringAttribute{
 				Description:         "The group's ID in the identity provider.",
-				MarkdownDescription: "The group's ID in the identity provider.",
+				MarkdownDescription: "The group's ID in the identity provider. Exactly one of `name` or `idp_id` is required when looking up by directory.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"raw_attributes": schema.StringAttribute{
+				Description:         "The group's attributes as sent by the identity provider, encoded as JSON.",
+				MarkdownDescription: "The group's attributes as sent by the identity provider, encoded as JSON. Use `jsondecode()` to read provider-specific fields such as Azure AD extension attributes. Null when the provider sent none.",
 				Computed:            true,
 			},
 			"created_at": schema.StringAttribute{
@@ -111,6 +154,15 @@ data "workos_directory_group" "engineering" {
 				MarkdownDescription: "The timestamp when the group was last updated (RFC3339 format).",
 				Computed:            true,
 			},
+			"wait_for_results": schema.StringAttribute{
+				Description: "How long to keep retrying the lookup while the group is not found, as a duration such as 5m. " +
+					"The initial sync of a new directory lags its creation, so a group looked up in the same apply may not exist yet. " +
+					"Defaults to failing on the first lookup.",
+				Optional: true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
 		},
 	}
 }
@@ -121,9 +173,10 @@ func (d *DirectoryGroupDataSource) ConfigValidators(ctx context.Context) []datas
 			path.MatchRoot("id"),
 			path.MatchRoot("directory_id"),
 		),
-		datasourcevalidator.RequiredTogether(
-			path.MatchRoot("directory_id"),
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
 			path.MatchRoot("name"),
+			path.MatchRoot("idp_id"),
 		),
 	}
 }
@@ -146,6 +199,8 @@ func (d *DirectoryGroupDataSource) Configure(ctx context.Context, req datasource
 }
 
 func (d *DirectoryGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
 	var config DirectoryGroupDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -161,7 +216,9 @@ func (d *DirectoryGroupDataSource) Read(ctx context.Context, req datasource.Read
 			"id": config.ID.ValueString(),
 		})
 
-		group, err = d.client.GetDirectoryGroup(ctx, config.ID.ValueString())
+		group, err = waitForDirectorySync(ctx, config.WaitForResults, func() (*client.DirectoryGroup, error) {
+			return d.client.GetDirectoryGroup(ctx, config.ID.ValueString())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading Directory Group",
@@ -169,17 +226,42 @@ func (d *DirectoryGroupDataSource) Read(ctx context.Context, req datasource.Read
 			)
 			return
 		}
+	} else if !config.DirectoryID.IsNull() && !config.IdpID.IsNull() {
+		tflog.Debug(ctx, "Reading directory group by IdP ID", map[string]any{
+			"directory_id": config.DirectoryID.ValueString(),
+			"idp_id":       config.IdpID.ValueString(),
+		})
+
+		group, err = waitForDirectorySync(ctx, config.WaitForResults, func() (*client.DirectoryGroup, error) {
+			return d.client.GetDirectoryGroupByIdpID(
+				ctx,
+				config.DirectoryID.ValueString(),
+				config.IdpID.ValueString(),
+			)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Directory Group",
+				fmt.Sprintf("Could not find group with idp_id %s in directory %s: %s",
+					config.IdpID.ValueString(),
+					config.DirectoryID.ValueString(),
+					err.Error()),
+			)
+			return
+		}
 	} else if !config.DirectoryID.IsNull() && !config.Name.IsNull() {
 		tflog.Debug(ctx, "Reading directory group by name", map[string]any{
 			"directory_id": config.DirectoryID.ValueString(),
 			"name":         config.Name.ValueString(),
 		})
 
-		group, err = d.client.GetDirectoryGroupByName(
-			ctx,
-			config.DirectoryID.ValueString(),
-			config.Name.ValueString(),
-		)
+		group, err = waitForDirectorySync(ctx, config.WaitForResults, func() (*client.DirectoryGroup, error) {
+			return d.client.GetDirectoryGroupByName(
+				ctx,
+				config.DirectoryID.ValueString(),
+				config.Name.ValueString(),
+			)
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading Directory Group",
@@ -198,6 +280,18 @@ func (d *DirectoryGroupDataSource) Read(ctx context.Context, req datasource.Read
 	config.OrganizationID = types.StringValue(group.OrganizationID)
 	config.Name = types.StringValue(group.Name)
 	config.IdpID = types.StringValue(group.IdpID)
+	config.RawAttributes = types.StringNull()
+	if len(group.RawAttributes) > 0 {
+		rawAttributes, err := json.Marshal(group.RawAttributes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Encoding Directory Group Attributes",
+				"Could not encode raw attributes for directory group "+group.ID+": "+err.Error(),
+			)
+			return
+		}
+		config.RawAttributes = types.StringValue(string(rawAttributes))
+	}
 	config.CreatedAt = types.StringValue(group.CreatedAt.Format(time.RFC3339))
 	config.UpdatedAt = types.StringValue(group.UpdatedAt.Format(time.RFC3339))
 
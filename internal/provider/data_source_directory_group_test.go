@@ -0,0 +1,146 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestDirectoryGroupDataSource_RawAttributes(t *testing.T) {
+	server := httptest.NewServer(directoryGroupDataSourceHandler(t, `{"displayName":"Engineering","extensionAttribute1":"entitlement:eng"}`))
+	defer server.Close()
+
+	state := readDirectoryGroupDataSource(t, server.URL, DirectoryGroupDataSourceModel{
+		ID: types.StringValue("directory_group_123"),
+	})
+
+	var attributes map[string]string
+	if err := json.Unmarshal([]byte(state.RawAttributes.ValueString()), &attributes); err != nil {
+		t.Fatalf("raw_attributes is not valid JSON: %v", err)
+	}
+	if attributes["extensionAttribute1"] != "entitlement:eng" {
+		t.Fatalf("unexpected raw attributes: %#v", attributes)
+	}
+}
+
+func TestDirectoryGroupDataSource_RawAttributesNullWhenAbsent(t *testing.T) {
+	server := httptest.NewServer(directoryGroupDataSourceHandler(t, ""))
+	defer server.Close()
+
+	state := readDirectoryGroupDataSource(t, server.URL, DirectoryGroupDataSourceModel{
+		ID: types.StringValue("directory_group_123"),
+	})
+
+	if !state.RawAttributes.IsNull() {
+		t.Fatalf("expected null raw_attributes, got %s", state.RawAttributes.ValueString())
+	}
+	if state.Name.ValueString() != "Engineering" {
+		t.Fatalf("unexpected name: %s", state.Name.ValueString())
+	}
+}
+
+func TestDirectoryGroupDataSource_ByIdpID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/directory_groups" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("directory"); got != "directory_123" {
+			t.Fatalf("expected directory=directory_123, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[
+  {"id":"directory_group_1","directory_id":"directory_123","organization_id":"org_123","idp_id":"group-1","name":"Engineering"},
+  {"id":"directory_group_2","directory_id":"directory_123","organization_id":"org_123","idp_id":"group-2","name":"Platform Engineering"}
+],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	state := readDirectoryGroupDataSource(t, server.URL, DirectoryGroupDataSourceModel{
+		DirectoryID: types.StringValue("directory_123"),
+		IdpID:       types.StringValue("group-2"),
+	})
+
+	if state.ID.ValueString() != "directory_group_2" {
+		t.Fatalf("unexpected ID: %s", state.ID.ValueString())
+	}
+	if state.Name.ValueString() != "Platform Engineering" {
+		t.Fatalf("unexpected name: %s", state.Name.ValueString())
+	}
+}
+
+func directoryGroupDataSourceHandler(t *testing.T, rawAttributes string) http.Handler {
+	t.Helper()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/directory_groups/directory_group_123" {
+			t.Fatalf("unexpected request path: %s", r.URL.Path)
+		}
+
+		body := `{"id":"directory_group_123","directory_id":"directory_123","organization_id":"org_123","idp_id":"group-1","name":"Engineering"`
+		if rawAttributes != "" {
+			body += `,"raw_attributes":` + rawAttributes
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body + `}`))
+	})
+}
+
+func readDirectoryGroupDataSource(t *testing.T, baseURL string, config DirectoryGroupDataSourceModel) DirectoryGroupDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &DirectoryGroupDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &config)
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state DirectoryGroupDataSourceModel
+	diags = readResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+
+	return state
+}
@@ -0,0 +1,207 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DirectoryGroupsDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DirectoryGroupsDataSource{}
+
+func NewDirectoryGroupsDataSource() datasource.DataSource {
+	return &DirectoryGroupsDataSource{}
+}
+
+// DirectoryGroupsDataSource defines the data source implementation.
+type DirectoryGroupsDataSource struct {
+	client *client.Client
+}
+
+// DirectoryGroupsDataSourceModel describes the data source data model.
+type DirectoryGroupsDataSourceModel struct {
+	DirectoryID types.String                  `tfsdk:"directory_id"`
+	UserID      types.String                  `tfsdk:"user_id"`
+	Groups      []DirectoryGroupListItemModel `tfsdk:"groups"`
+}
+
+// DirectoryGroupListItemModel describes a single directory group in a list.
+type DirectoryGroupListItemModel struct {
+	ID             types.String `tfsdk:"id"`
+	DirectoryID    types.String `tfsdk:"directory_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Name           types.String `tfsdk:"name"`
+	IdpID          types.String `tfsdk:"idp_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (d *DirectoryGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_groups"
+}
+
+func (d *DirectoryGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the groups synced from a WorkOS Directory or the groups a directory user belongs to.",
+		MarkdownDescription: `
+Use this data source to list the groups synced from a WorkOS Directory or the groups a directory user belongs to.
+
+When ` + "`user_id`" + ` is set, WorkOS filters the groups server-side, which makes
+per-user entitlement lookups cheap even for large directories.
+
+## Example Usage
+
+### All Groups in a Directory
+
+` + "```hcl" + `
+data "workos_directory_groups" "all" {
+  directory_id = data.workos_directory.main.id
+}
+` + "```" + `
+
+### Groups of a User
+
+` + "```hcl" + `
+data "workos_directory_groups" "jane" {
+  user_id = data.workos_directory_user.jane.id
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				Description:         "The ID of the directory whose groups to list.",
+				MarkdownDescription: "The ID of the directory whose groups to list. At least one of `directory_id` or `user_id` must be set.",
+				Optional:            true,
+			},
+			"user_id": schema.StringAttribute{
+				Description:         "Only list groups that this directory user belongs to.",
+				MarkdownDescription: "Only list groups that this directory user belongs to. At least one of `directory_id` or `user_id` must be set.",
+				Optional:            true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Description:         "The directory groups matching the filters.",
+				MarkdownDescription: "The directory groups matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the directory group.",
+							Computed:    true,
+						},
+						"directory_id": schema.StringAttribute{
+							Description: "The ID of the directory the group was synced from.",
+							Computed:    true,
+						},
+						"organization_id": schema.StringAttribute{
+							Description: "The organization ID the group belongs to.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the group.",
+							Computed:    true,
+						},
+						"idp_id": schema.StringAttribute{
+							Description: "The group's ID in the identity provider.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp when the group was synced.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The timestamp when the group was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DirectoryGroupsDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("directory_id"),
+			path.MatchRoot("user_id"),
+		),
+	}
+}
+
+func (d *DirectoryGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DirectoryGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
+	var config DirectoryGroupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directoryID := config.DirectoryID.ValueString()
+	userID := config.UserID.ValueString()
+
+	tflog.Debug(ctx, "Listing directory groups", map[string]any{
+		"directory_id": directoryID,
+		"user_id":      userID,
+	})
+
+	groups, err := d.client.ListDirectoryGroups(ctx, directoryID, userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Directory Groups",
+			"Could not list directory groups: "+err.Error(),
+		)
+		return
+	}
+
+	config.Groups = make([]DirectoryGroupListItemModel, 0, len(groups.Data))
+	for _, group := range groups.Data {
+		config.Groups = append(config.Groups, DirectoryGroupListItemModel{
+			ID:             types.StringValue(group.ID),
+			DirectoryID:    types.StringValue(group.DirectoryID),
+			OrganizationID: types.StringValue(group.OrganizationID),
+			Name:           types.StringValue(group.Name),
+			IdpID:          types.StringValue(group.IdpID),
+			CreatedAt:      types.StringValue(group.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:      types.StringValue(group.UpdatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	tflog.Info(ctx, "Read directory groups", map[string]any{
+		"directory_id": directoryID,
+		"user_id":      userID,
+		"count":        len(config.Groups),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestDirectoryGroupsDataSource_FiltersByUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/directory_groups" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("user"); got != "directory_user_1" {
+			t.Fatalf("expected user=directory_user_1, got %q", got)
+		}
+		if r.URL.Query().Has("directory") {
+			t.Fatalf("expected no directory filter, got %q", r.URL.Query().Get("directory"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[
+  {"id":"directory_group_eng","directory_id":"directory_123","organization_id":"org_123","idp_id":"00g1","name":"Engineering","created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"},
+  {"id":"directory_group_ops","directory_id":"directory_123","organization_id":"org_123","idp_id":"00g2","name":"Operations","created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"}
+],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	state := readDirectoryGroupsDataSource(t, server.URL, DirectoryGroupsDataSourceModel{
+		DirectoryID: types.StringNull(),
+		UserID:      types.StringValue("directory_user_1"),
+	})
+
+	if len(state.Groups) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(state.Groups))
+	}
+	if state.Groups[0].Name.ValueString() != "Engineering" || state.Groups[1].IdpID.ValueString() != "00g2" {
+		t.Fatalf("unexpected groups: %#v", state.Groups)
+	}
+}
+
+func readDirectoryGroupsDataSource(t *testing.T, baseURL string, config DirectoryGroupsDataSourceModel) DirectoryGroupsDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &DirectoryGroupsDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &config)
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state DirectoryGroupsDataSourceModel
+	diags = readResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+
+	return state
+}
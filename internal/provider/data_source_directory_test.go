@@ -0,0 +1,139 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestDirectoryDataSource_IncludeBearerToken(t *testing.T) {
+	var fetchedDirectory bool
+	server := httptest.NewServer(directoryDataSourceHandler(t, &fetchedDirectory))
+	defer server.Close()
+
+	config := testDirectoryDataSourceConfig()
+	config.OrganizationID = types.StringValue("org_123")
+	config.IncludeBearerToken = types.BoolValue(true)
+
+	state := readDirectoryDataSource(t, server.URL, config)
+
+	if !fetchedDirectory {
+		t.Fatal("expected the directory to be fetched by ID for its bearer token")
+	}
+	if state.BearerToken.ValueString() != "scim_token_123" {
+		t.Fatalf("unexpected bearer token: %s", state.BearerToken.ValueString())
+	}
+}
+
+func TestDirectoryDataSource_OmitsBearerTokenByDefault(t *testing.T) {
+	var fetchedDirectory bool
+	server := httptest.NewServer(directoryDataSourceHandler(t, &fetchedDirectory))
+	defer server.Close()
+
+	config := testDirectoryDataSourceConfig()
+	config.OrganizationID = types.StringValue("org_123")
+
+	state := readDirectoryDataSource(t, server.URL, config)
+
+	if fetchedDirectory {
+		t.Fatal("expected no directory fetch when include_bearer_token is unset")
+	}
+	if !state.BearerToken.IsNull() {
+		t.Fatalf("expected null bearer token, got %s", state.BearerToken.ValueString())
+	}
+	if state.ID.ValueString() != "directory_123" {
+		t.Fatalf("unexpected ID: %s", state.ID.ValueString())
+	}
+}
+
+func testDirectoryDataSourceConfig() DirectoryDataSourceModel {
+	return DirectoryDataSourceModel{
+		ID:                 types.StringNull(),
+		OrganizationID:     types.StringNull(),
+		Name:               types.StringNull(),
+		Type:               types.StringNull(),
+		State:              types.StringNull(),
+		Endpoint:           types.StringNull(),
+		IncludeBearerToken: types.BoolNull(),
+		BearerToken:        types.StringNull(),
+		CreatedAt:          types.StringNull(),
+		UpdatedAt:          types.StringNull(),
+	}
+}
+
+func directoryDataSourceHandler(t *testing.T, fetchedDirectory *bool) http.Handler {
+	t.Helper()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/directories":
+			_, _ = w.Write([]byte(`{"data":[{"id":"directory_123","organization_id":"org_123","name":"Okta","type":"okta scim v2.0","state":"linked"}],"list_metadata":{}}`))
+		case "/directories/directory_123":
+			*fetchedDirectory = true
+			_, _ = w.Write([]byte(`{"id":"directory_123","organization_id":"org_123","name":"Okta","type":"okta scim v2.0","state":"linked","bearer_token":"scim_token_123"}`))
+		default:
+			t.Fatalf("unexpected request path: %s", r.URL.Path)
+		}
+	})
+}
+
+func readDirectoryDataSource(t *testing.T, baseURL string, config DirectoryDataSourceModel) DirectoryDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &DirectoryDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &config)
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state DirectoryDataSourceModel
+	diags = readResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+
+	return state
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/osodevops/terraform-provider-workos/internal/client"
@@ -43,6 +44,7 @@ type DirectoryUserDataSourceModel struct {
 	IdpID          types.String `tfsdk:"idp_id"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
+	WaitForResults types.String `tfsdk:"wait_for_results"`
 }
 
 func (d *DirectoryUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -55,7 +57,9 @@ func (d *DirectoryUserDataSource) Schema(ctx context.Context, req datasource.Sch
 		MarkdownDescription: `
 Use this data source to get information about a user synced from a WorkOS Directory.
 
-You can look up a user by ID or by directory ID and email.
+You can look up a user by ID, or by directory ID and either email or identity
+provider ID. Looking up by ` + "`idp_id`" + ` keeps working when the user's email
+changes in the identity provider.
 
 ## Example Usage
 
@@ -75,6 +79,29 @@ data "workos_directory_user" "john" {
   email        = "john@example.com"
 }
 ` + "```" + `
+
+### By Directory and Identity Provider ID
+
+` + "```hcl" + `
+data "workos_directory_user" "john" {
+  directory_id = data.workos_directory.main.id
+  idp_id       = "00u1a2b3c4d5e6f7g8h9"
+}
+` + "```" + `
+
+### Waiting for the Initial Sync
+
+The initial sync of a new directory lags its creation. Set ` + "`wait_for_results`" + `
+to keep retrying a lookup while the user is not found, so it can be read in the
+same apply that sets the directory up.
+
+` + "```hcl" + `
+data "workos_directory_user" "admin" {
+  directory_id     = data.workos_directory.main.id
+  email            = "admin@example.com"
+  wait_for_results = "10m"
+}
+` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -85,7 +112,7 @@ data "workos_directory_user" "john" {
 			},
 			"directory_id": schema.StringAttribute{
 				Description:         "The ID of the directory to search in.",
-				MarkdownDescription: "The ID of the directory to search in. Required when looking up by email.",
+				MarkdownDescription: "The ID of the directory to search in. Required when looking up by email or `idp_id`.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -96,7 +123,7 @@ data "workos_directory_user" "john" {
 			},
 			"email": schema.StringAttribute{
 				Description:         "The email address of the user.",
-				MarkdownDescription: "The email address of the user. Required when looking up by directory.",
+				MarkdownDescription: "The email address of the user. Exactly one of `email` or `idp_id` is required when looking up by directory.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -122,7 +149,8 @@ data "workos_directory_user" "john" {
 			},
 			"idp_id": schema.StringAttribute{
 				Description:         "The user's ID in the identity provider.",
-				MarkdownDescription: "The user's ID in the identity provider.",
+				MarkdownDescription: "The user's ID in the identity provider (e.g., the Okta user ID). Exactly one of `email` or `idp_id` is required when looking up by directory.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"created_at": schema.StringAttribute{
@@ -135,6 +163,15 @@ data "workos_directory_user" "john" {
 				MarkdownDescription: "The timestamp when the user was last updated (RFC3339 format).",
 				Computed:            true,
 			},
+			"wait_for_results": schema.StringAttribute{
+				Description: "How long to keep retrying the lookup while the user is not found, as a duration such as 5m. " +
+					"The initial sync of a new directory lags its creation, so a user looked up in the same apply may not exist yet. " +
+					"Defaults to failing on the first lookup.",
+				Optional: true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
 		},
 	}
 }
@@ -145,9 +182,10 @@ func (d *DirectoryUserDataSource) ConfigValidators(ctx context.Context) []dataso
 			path.MatchRoot("id"),
 			path.MatchRoot("directory_id"),
 		),
-		datasourcevalidator.RequiredTogether(
-			path.MatchRoot("directory_id"),
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
 			path.MatchRoot("email"),
+			path.MatchRoot("idp_id"),
 		),
 	}
 }
@@ -170,6 +208,8 @@ func (d *DirectoryUserDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *DirectoryUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
 	var config DirectoryUserDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -185,7 +225,9 @@ func (d *DirectoryUserDataSource) Read(ctx context.Context, req datasource.ReadR
 			"id": config.ID.ValueString(),
 		})
 
-		user, err = d.client.GetDirectoryUser(ctx, config.ID.ValueString())
+		user, err = waitForDirectorySync(ctx, config.WaitForResults, func() (*client.DirectoryUser, error) {
+			return d.client.GetDirectoryUser(ctx, config.ID.ValueString())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading Directory User",
@@ -193,17 +235,42 @@ func (d *DirectoryUserDataSource) Read(ctx context.Context, req datasource.ReadR
 			)
 			return
 		}
+	} else if !config.DirectoryID.IsNull() && !config.IdpID.IsNull() {
+		tflog.Debug(ctx, "Reading directory user by IdP ID", map[string]any{
+			"directory_id": config.DirectoryID.ValueString(),
+			"idp_id":       config.IdpID.ValueString(),
+		})
+
+		user, err = waitForDirectorySync(ctx, config.WaitForResults, func() (*client.DirectoryUser, error) {
+			return d.client.GetDirectoryUserByIdpID(
+				ctx,
+				config.DirectoryID.ValueString(),
+				config.IdpID.ValueString(),
+			)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Directory User",
+				fmt.Sprintf("Could not find user with idp_id %s in directory %s: %s",
+					config.IdpID.ValueString(),
+					config.DirectoryID.ValueString(),
+					err.Error()),
+			)
+			return
+		}
 	} else if !config.DirectoryID.IsNull() && !config.Email.IsNull() {
 		tflog.Debug(ctx, "Reading directory user by email", map[string]any{
 			"directory_id": config.DirectoryID.ValueString(),
 			"email":        config.Email.ValueString(),
 		})
 
-		user, err = d.client.GetDirectoryUserByEmail(
-			ctx,
-			config.DirectoryID.ValueString(),
-			config.Email.ValueString(),
-		)
+		user, err = waitForDirectorySync(ctx, config.WaitForResults, func() (*client.DirectoryUser, error) {
+			return d.client.GetDirectoryUserByEmail(
+				ctx,
+				config.DirectoryID.ValueString(),
+				config.Email.ValueString(),
+			)
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading Directory User",
@@ -0,0 +1,172 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestDirectoryUserDataSource_ByIdpID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/directory_users" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("directory"); got != "directory_123" {
+			t.Fatalf("expected directory=directory_123, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[
+  {"id":"directory_user_1","directory_id":"directory_123","organization_id":"org_123","idp_id":"00u1","email":"jane@example.com","state":"active"},
+  {"id":"directory_user_2","directory_id":"directory_123","organization_id":"org_123","idp_id":"00u2","email":"john.new@example.com","state":"active"}
+],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	state := readDirectoryUserDataSource(t, server.URL, DirectoryUserDataSourceModel{
+		DirectoryID: types.StringValue("directory_123"),
+		IdpID:       types.StringValue("00u2"),
+	})
+
+	if state.ID.ValueString() != "directory_user_2" {
+		t.Fatalf("unexpected ID: %s", state.ID.ValueString())
+	}
+	if state.Email.ValueString() != "john.new@example.com" {
+		t.Fatalf("unexpected email: %s", state.Email.ValueString())
+	}
+}
+
+func TestDirectoryUserDataSource_WaitForResults(t *testing.T) {
+	pollInterval := directorySyncPollInterval
+	directorySyncPollInterval = time.Millisecond
+	t.Cleanup(func() { directorySyncPollInterval = pollInterval })
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/directory_users" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if requests < 3 {
+			_, _ = w.Write([]byte(`{"data":[],"list_metadata":{}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[
+  {"id":"directory_user_1","directory_id":"directory_123","organization_id":"org_123","idp_id":"00u1","email":"jane@example.com","state":"active"}
+],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	state := readDirectoryUserDataSource(t, server.URL, DirectoryUserDataSourceModel{
+		DirectoryID:    types.StringValue("directory_123"),
+		Email:          types.StringValue("jane@example.com"),
+		WaitForResults: types.StringValue("1m"),
+	})
+
+	if requests != 3 {
+		t.Fatalf("expected 3 lookups, got %d", requests)
+	}
+	if state.ID.ValueString() != "directory_user_1" {
+		t.Fatalf("unexpected ID: %s", state.ID.ValueString())
+	}
+}
+
+func TestDirectoryUserDataSource_ConfigValidators(t *testing.T) {
+	testCases := map[string]struct {
+		config      DirectoryUserDataSourceModel
+		expectError bool
+	}{
+		"id":                  {config: DirectoryUserDataSourceModel{ID: types.StringValue("directory_user_1")}},
+		"directory and email": {config: DirectoryUserDataSourceModel{DirectoryID: types.StringValue("directory_123"), Email: types.StringValue("jane@example.com")}},
+		"directory and idp":   {config: DirectoryUserDataSourceModel{DirectoryID: types.StringValue("directory_123"), IdpID: types.StringValue("00u1")}},
+		"directory only":      {config: DirectoryUserDataSourceModel{DirectoryID: types.StringValue("directory_123")}, expectError: true},
+		"email and idp":       {config: DirectoryUserDataSourceModel{DirectoryID: types.StringValue("directory_123"), Email: types.StringValue("jane@example.com"), IdpID: types.StringValue("00u1")}, expectError: true},
+		"idp without dir":     {config: DirectoryUserDataSourceModel{IdpID: types.StringValue("00u1")}, expectError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			dataSource := &DirectoryUserDataSource{}
+
+			schemaResp := &datasource.SchemaResponse{}
+			dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+			configState := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := configState.Set(ctx, &tc.config); diags.HasError() {
+				t.Fatalf("failed to build config state: %v", diags)
+			}
+
+			var diags diag.Diagnostics
+			for _, validator := range dataSource.ConfigValidators(ctx) {
+				resp := &datasource.ValidateConfigResponse{}
+				validator.ValidateDataSource(ctx, datasource.ValidateConfigRequest{
+					Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw},
+				}, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+
+			if diags.HasError() != tc.expectError {
+				t.Fatalf("expected error=%t, got diagnostics: %v", tc.expectError, diags)
+			}
+		})
+	}
+}
+
+func readDirectoryUserDataSource(t *testing.T, baseURL string, config DirectoryUserDataSourceModel) DirectoryUserDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &DirectoryUserDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &config)
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state DirectoryUserDataSourceModel
+	diags = readResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+
+	return state
+}
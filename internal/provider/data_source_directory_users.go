@@ -0,0 +1,231 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DirectoryUsersDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DirectoryUsersDataSource{}
+
+func NewDirectoryUsersDataSource() datasource.DataSource {
+	return &DirectoryUsersDataSource{}
+}
+
+// DirectoryUsersDataSource defines the data source implementation.
+type DirectoryUsersDataSource struct {
+	client *client.Client
+}
+
+// DirectoryUsersDataSourceModel describes the data source data model.
+type DirectoryUsersDataSourceModel struct {
+	DirectoryID types.String                 `tfsdk:"directory_id"`
+	GroupID     types.String                 `tfsdk:"group_id"`
+	Users       []DirectoryUserListItemModel `tfsdk:"users"`
+}
+
+// DirectoryUserListItemModel describes a single directory user in a list.
+type DirectoryUserListItemModel struct {
+	ID             types.String `tfsdk:"id"`
+	DirectoryID    types.String `tfsdk:"directory_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Email          types.String `tfsdk:"email"`
+	FirstName      types.String `tfsdk:"first_name"`
+	LastName       types.String `tfsdk:"last_name"`
+	Username       types.String `tfsdk:"username"`
+	State          types.String `tfsdk:"state"`
+	IdpID          types.String `tfsdk:"idp_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (d *DirectoryUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_users"
+}
+
+func (d *DirectoryUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the users synced from a WorkOS Directory or belonging to a directory group.",
+		MarkdownDescription: `
+Use this data source to list the users synced from a WorkOS Directory or belonging to a directory group.
+
+When ` + "`group_id`" + ` is set, WorkOS filters the users server-side, so only the
+members of that group are fetched.
+
+## Example Usage
+
+### All Users in a Directory
+
+` + "```hcl" + `
+data "workos_directory_users" "all" {
+  directory_id = data.workos_directory.main.id
+}
+` + "```" + `
+
+### Users in a Group
+
+` + "```hcl" + `
+data "workos_directory_users" "engineering" {
+  group_id = data.workos_directory_group.engineering.id
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				Description:         "The ID of the directory whose users to list.",
+				MarkdownDescription: "The ID of the directory whose users to list. At least one of `directory_id` or `group_id` must be set.",
+				Optional:            true,
+			},
+			"group_id": schema.StringAttribute{
+				Description:         "Only list users that belong to this directory group.",
+				MarkdownDescription: "Only list users that belong to this directory group. At least one of `directory_id` or `group_id` must be set.",
+				Optional:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description:         "The directory users matching the filters.",
+				MarkdownDescription: "The directory users matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the directory user.",
+							Computed:    true,
+						},
+						"directory_id": schema.StringAttribute{
+							Description: "The ID of the directory the user was synced from.",
+							Computed:    true,
+						},
+						"organization_id": schema.StringAttribute{
+							Description: "The organization ID the user belongs to.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Computed:    true,
+						},
+						"first_name": schema.StringAttribute{
+							Description: "The user's first name.",
+							Computed:    true,
+						},
+						"last_name": schema.StringAttribute{
+							Description: "The user's last name.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The user's username.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The state of the directory user.",
+							Computed:    true,
+						},
+						"idp_id": schema.StringAttribute{
+							Description: "The user's ID in the identity provider.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp when the user was synced.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The timestamp when the user was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DirectoryUsersDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("directory_id"),
+			path.MatchRoot("group_id"),
+		),
+	}
+}
+
+func (d *DirectoryUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DirectoryUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
+	var config DirectoryUsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	directoryID := config.DirectoryID.ValueString()
+	groupID := config.GroupID.ValueString()
+
+	tflog.Debug(ctx, "Listing directory users", map[string]any{
+		"directory_id": directoryID,
+		"group_id":     groupID,
+	})
+
+	users, err := d.client.ListDirectoryUsers(ctx, directoryID, groupID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Directory Users",
+			"Could not list directory users: "+err.Error(),
+		)
+		return
+	}
+
+	config.Users = make([]DirectoryUserListItemModel, 0, len(users.Data))
+	for _, user := range users.Data {
+		config.Users = append(config.Users, DirectoryUserListItemModel{
+			ID:             types.StringValue(user.ID),
+			DirectoryID:    types.StringValue(user.DirectoryID),
+			OrganizationID: types.StringValue(user.OrganizationID),
+			Email:          types.StringValue(user.Email),
+			FirstName:      types.StringValue(user.FirstName),
+			LastName:       types.StringValue(user.LastName),
+			Username:       optionalString(&user.Username),
+			State:          types.StringValue(user.State),
+			IdpID:          types.StringValue(user.IdpID),
+			CreatedAt:      types.StringValue(user.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:      types.StringValue(user.UpdatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	tflog.Info(ctx, "Read directory users", map[string]any{
+		"directory_id": directoryID,
+		"group_id":     groupID,
+		"count":        len(config.Users),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestDirectoryUsersDataSource_FiltersByGroup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/directory_users" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("group"); got != "directory_group_eng" {
+			t.Fatalf("expected group=directory_group_eng, got %q", got)
+		}
+		if got := r.URL.Query().Get("directory"); got != "directory_123" {
+			t.Fatalf("expected directory=directory_123, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[
+  {"id":"directory_user_1","directory_id":"directory_123","organization_id":"org_123","idp_id":"00u1","email":"jane@example.com","first_name":"Jane","last_name":"Doe","state":"active","created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"}
+],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	state := readDirectoryUsersDataSource(t, server.URL, DirectoryUsersDataSourceModel{
+		DirectoryID: types.StringValue("directory_123"),
+		GroupID:     types.StringValue("directory_group_eng"),
+	})
+
+	if len(state.Users) != 1 {
+		t.Fatalf("expected 1 user, got %d", len(state.Users))
+	}
+	user := state.Users[0]
+	if user.ID.ValueString() != "directory_user_1" || user.IdpID.ValueString() != "00u1" {
+		t.Fatalf("unexpected user: %#v", user)
+	}
+	if !user.Username.IsNull() {
+		t.Fatalf("expected null username, got %s", user.Username)
+	}
+}
+
+func TestDirectoryUsersDataSource_NoUsers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("group") {
+			t.Fatalf("expected no group filter, got %q", r.URL.Query().Get("group"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	state := readDirectoryUsersDataSource(t, server.URL, DirectoryUsersDataSourceModel{
+		DirectoryID: types.StringValue("directory_123"),
+		GroupID:     types.StringNull(),
+	})
+
+	if state.Users == nil || len(state.Users) != 0 {
+		t.Fatalf("expected an empty user list, got %#v", state.Users)
+	}
+}
+
+func readDirectoryUsersDataSource(t *testing.T, baseURL string, config DirectoryUsersDataSourceModel) DirectoryUsersDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &DirectoryUsersDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &config)
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state DirectoryUsersDataSourceModel
+	diags = readResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+
+	return state
+}
@@ -151,6 +151,8 @@ func (d *EnvironmentRoleDataSource) Configure(ctx context.Context, req datasourc
 }
 
 func (d *EnvironmentRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
 	var config EnvironmentRoleDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -0,0 +1,218 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InvitationDataSource{}
+
+func NewInvitationDataSource() datasource.DataSource {
+	return &InvitationDataSource{}
+}
+
+// InvitationDataSource defines the data source implementation.
+type InvitationDataSource struct {
+	client *client.Client
+}
+
+// InvitationDataSourceModel describes the data source data model.
+type InvitationDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Email          types.String `tfsdk:"email"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	State          types.String `tfsdk:"state"`
+	InviterUserID  types.String `tfsdk:"inviter_user_id"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	AcceptedAt     types.String `tfsdk:"accepted_at"`
+	RevokedAt      types.String `tfsdk:"revoked_at"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (d *InvitationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invitation"
+}
+
+func (d *InvitationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to look up the most recent AuthKit invitation sent to an email address.",
+		MarkdownDescription: `
+Use this data source to look up the most recent AuthKit invitation sent to an
+email address, optionally within an organization.
+
+The invitation's ` + "`state`" + ` and ` + "`expires_at`" + ` can be used to decide whether to
+re-send it (for example with ` + "`workos_invitation_resend`" + `), revoke it, or leave it alone.
+
+## Example Usage
+
+` + "```hcl" + `
+data "workos_invitation" "jane_acme" {
+  email           = "jane@example.com"
+  organization_id = data.workos_organization.acme.id
+}
+
+resource "workos_invitation_resend" "jane_acme" {
+  count = data.workos_invitation.jane_acme.state == "expired" ? 1 : 0
+
+  invitation_id = data.workos_invitation.jane_acme.id
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The unique identifier of the invitation.",
+				MarkdownDescription: "The unique identifier of the invitation (e.g., `invitation_01HXYZ...`).",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				Description:         "The email address the invitation was sent to.",
+				MarkdownDescription: "The email address the invitation was sent to.",
+				Required:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description:         "Only consider invitations to this organization.",
+				MarkdownDescription: "Only consider invitations to this organization. When unset, the most recent invitation to any organization or to the environment is returned.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				Description:         "The state of the invitation.",
+				MarkdownDescription: "The state of the invitation (`pending`, `accepted`, `expired`, `revoked`).",
+				Computed:            true,
+			},
+			"inviter_user_id": schema.StringAttribute{
+				Description:         "The ID of the user who sent the invitation.",
+				MarkdownDescription: "The ID of the user who sent the invitation, if it was sent on behalf of a user.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description:         "The timestamp when the invitation expires.",
+				MarkdownDescription: "The timestamp when the invitation expires (RFC3339 format).",
+				Computed:            true,
+			},
+			"accepted_at": schema.StringAttribute{
+				Description:         "The timestamp when the invitation was accepted.",
+				MarkdownDescription: "The timestamp when the invitation was accepted (RFC3339 format), if it has been.",
+				Computed:            true,
+			},
+			"revoked_at": schema.StringAttribute{
+				Description:         "The timestamp when the invitation was revoked.",
+				MarkdownDescription: "The timestamp when the invitation was revoked (RFC3339 format), if it has been.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				Description:         "The timestamp when the invitation was sent.",
+				MarkdownDescription: "The timestamp when the invitation was sent (RFC3339 format).",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description:         "The timestamp when the invitation was last updated.",
+				MarkdownDescription: "The timestamp when the invitation was last updated (RFC3339 format).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *InvitationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InvitationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
+	var config InvitationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := config.Email.ValueString()
+	organizationID := config.OrganizationID.ValueString()
+
+	tflog.Debug(ctx, "Reading invitation", map[string]any{
+		"email":           email,
+		"organization_id": organizationID,
+	})
+
+	invitations, err := d.client.ListInvitations(ctx, email, organizationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Invitation",
+			"Could not list invitations for "+email+": "+err.Error(),
+		)
+		return
+	}
+
+	// Earlier invitations are kept after they expire or are revoked, so the
+	// most recently sent one is the one that matters.
+	var invitation *client.Invitation
+	for i := range invitations.Data {
+		if organizationID != "" && invitations.Data[i].OrganizationID != organizationID {
+			continue
+		}
+		if invitation == nil || invitations.Data[i].CreatedAt.After(invitation.CreatedAt) {
+			invitation = &invitations.Data[i]
+		}
+	}
+	if invitation == nil {
+		detail := "No invitation has been sent to " + email + "."
+		if organizationID != "" {
+			detail = fmt.Sprintf("No invitation to organization %s has been sent to %s.", organizationID, email)
+		}
+		resp.Diagnostics.AddError("Invitation Not Found", detail)
+		return
+	}
+
+	// Map response to state
+	config.ID = types.StringValue(invitation.ID)
+	config.OrganizationID = optionalString(&invitation.OrganizationID)
+	config.State = types.StringValue(invitation.State)
+	config.InviterUserID = optionalString(&invitation.InviterUserID)
+	config.ExpiresAt = types.StringValue(invitation.ExpiresAt.Format(time.RFC3339))
+	config.AcceptedAt = optionalTime(invitation.AcceptedAt)
+	config.RevokedAt = optionalTime(invitation.RevokedAt)
+	config.CreatedAt = types.StringValue(invitation.CreatedAt.Format(time.RFC3339))
+	config.UpdatedAt = types.StringValue(invitation.UpdatedAt.Format(time.RFC3339))
+
+	tflog.Info(ctx, "Read invitation", map[string]any{
+		"id":    invitation.ID,
+		"state": invitation.State,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// optionalTime formats an optional timestamp, null when it is unset.
+func optionalTime(value *time.Time) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(value.Format(time.RFC3339))
+}
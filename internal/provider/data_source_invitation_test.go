@@ -0,0 +1,98 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestInvitationDataSource_ReturnsMostRecentInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/user_management/invitations" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("email"); got != "jane@example.com" {
+			t.Fatalf("expected email=jane@example.com, got %q", got)
+		}
+		if got := r.URL.Query().Get("organization_id"); got != "org_123" {
+			t.Fatalf("expected organization_id=org_123, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[
+  {"id":"invitation_old","email":"jane@example.com","state":"revoked","organization_id":"org_123","revoked_at":"2026-01-10T12:00:00.000Z","expires_at":"2026-01-16T12:00:00.000Z","created_at":"2026-01-09T12:00:00.000Z","updated_at":"2026-01-10T12:00:00.000Z"},
+  {"id":"invitation_new","email":"jane@example.com","state":"expired","organization_id":"org_123","expires_at":"2026-01-22T12:00:00.000Z","created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"}
+],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	state := readInvitationDataSource(t, server.URL, InvitationDataSourceModel{
+		Email:          types.StringValue("jane@example.com"),
+		OrganizationID: types.StringValue("org_123"),
+	})
+
+	if state.ID.ValueString() != "invitation_new" || state.State.ValueString() != "expired" {
+		t.Fatalf("expected the most recent invitation, got %s (%s)", state.ID.ValueString(), state.State.ValueString())
+	}
+	if state.ExpiresAt.ValueString() != "2026-01-22T12:00:00Z" {
+		t.Fatalf("unexpected expires_at: %s", state.ExpiresAt.ValueString())
+	}
+	if !state.RevokedAt.IsNull() || !state.AcceptedAt.IsNull() {
+		t.Fatalf("expected no accepted_at or revoked_at, got %s and %s", state.AcceptedAt, state.RevokedAt)
+	}
+}
+
+func readInvitationDataSource(t *testing.T, baseURL string, config InvitationDataSourceModel) InvitationDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &InvitationDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &config)
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state InvitationDataSourceModel
+	diags = readResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+
+	return state
+}
@@ -0,0 +1,215 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &JWKSDataSource{}
+
+func NewJWKSDataSource() datasource.DataSource {
+	return &JWKSDataSource{}
+}
+
+// JWKSDataSource defines the data source implementation.
+type JWKSDataSource struct {
+	client *client.Client
+}
+
+// JWKSDataSourceModel describes the data source data model.
+type JWKSDataSourceModel struct {
+	ClientID types.String   `tfsdk:"client_id"`
+	URL      types.String   `tfsdk:"url"`
+	JSON     types.String   `tfsdk:"json"`
+	Keys     []JWKListModel `tfsdk:"keys"`
+}
+
+// JWKListModel describes a single public key in the key set.
+type JWKListModel struct {
+	Kid     types.String   `tfsdk:"kid"`
+	Kty     types.String   `tfsdk:"kty"`
+	Alg     types.String   `tfsdk:"alg"`
+	Use     types.String   `tfsdk:"use"`
+	N       types.String   `tfsdk:"n"`
+	E       types.String   `tfsdk:"e"`
+	X5C     []types.String `tfsdk:"x5c"`
+	X5TS256 types.String   `tfsdk:"x5t_s256"`
+}
+
+func (d *JWKSDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jwks"
+}
+
+func (d *JWKSDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to read the public keys WorkOS signs AuthKit access tokens with.",
+		MarkdownDescription: `
+Use this data source to read the JSON Web Key Set (JWKS) WorkOS signs AuthKit
+access tokens with.
+
+API gateways and services that verify WorkOS access tokens can be configured
+with the key set URL, or with the keys themselves when they cannot fetch it.
+
+## Example Usage
+
+` + "```hcl" + `
+data "workos_jwks" "this" {}
+
+output "workos_jwks_url" {
+  value = data.workos_jwks.this.url
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Description:         "The WorkOS client ID the key set belongs to. Defaults to the provider's client_id.",
+				MarkdownDescription: "The WorkOS client ID the key set belongs to. Defaults to the provider's `client_id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The public URL of the key set.",
+				Computed:    true,
+			},
+			"json": schema.StringAttribute{
+				Description: "The key set as the JSON document WorkOS serves.",
+				Computed:    true,
+			},
+			"keys": schema.ListNestedAttribute{
+				Description: "The public keys in the key set.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kid": schema.StringAttribute{
+							Description: "The key ID, matched against the kid header of a token.",
+							Computed:    true,
+						},
+						"kty": schema.StringAttribute{
+							Description: "The key type, such as RSA.",
+							Computed:    true,
+						},
+						"alg": schema.StringAttribute{
+							Description: "The signing algorithm, such as RS256.",
+							Computed:    true,
+						},
+						"use": schema.StringAttribute{
+							Description: "The intended use of the key, such as sig.",
+							Computed:    true,
+						},
+						"n": schema.StringAttribute{
+							Description: "The base64url-encoded RSA modulus.",
+							Computed:    true,
+						},
+						"e": schema.StringAttribute{
+							Description: "The base64url-encoded RSA public exponent.",
+							Computed:    true,
+						},
+						"x5c": schema.ListAttribute{
+							Description: "The base64-encoded X.509 certificate chain of the key.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"x5t_s256": schema.StringAttribute{
+							Description: "The base64url-encoded SHA-256 thumbprint of the key's certificate.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *JWKSDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *JWKSDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
+	var config JWKSDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientID := d.client.ClientID()
+	if !config.ClientID.IsNull() {
+		clientID = config.ClientID.ValueString()
+	}
+	if clientID == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_id"),
+			"Missing WorkOS Client ID",
+			"The key set belongs to a WorkOS client ID. Set client_id on this data source or on the provider, or use the WORKOS_CLIENT_ID environment variable.",
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Reading JWKS", map[string]any{
+		"client_id": clientID,
+	})
+
+	jwks, err := d.client.GetJWKS(ctx, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading JWKS",
+			"Could not read the key set for client ID "+clientID+": "+err.Error(),
+		)
+		return
+	}
+
+	config.ClientID = types.StringValue(clientID)
+	config.URL = types.StringValue(d.client.JWKSURL(clientID))
+	config.JSON = types.StringValue(string(jwks.Raw))
+	config.Keys = make([]JWKListModel, 0, len(jwks.Keys))
+	for _, key := range jwks.Keys {
+		x5c := make([]types.String, 0, len(key.X5C))
+		for _, certificate := range key.X5C {
+			x5c = append(x5c, types.StringValue(certificate))
+		}
+
+		config.Keys = append(config.Keys, JWKListModel{
+			Kid:     types.StringValue(key.Kid),
+			Kty:     types.StringValue(key.Kty),
+			Alg:     optionalString(&key.Alg),
+			Use:     optionalString(&key.Use),
+			N:       optionalString(&key.N),
+			E:       optionalString(&key.E),
+			X5C:     x5c,
+			X5TS256: optionalString(&key.X5TS256),
+		})
+	}
+
+	tflog.Info(ctx, "Read JWKS", map[string]any{
+		"client_id": clientID,
+		"count":     len(config.Keys),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+const jwksFixture = `{"keys":[{"alg":"RS256","kty":"RSA","use":"sig","n":"0vx7agoebGcQSuu","e":"AQAB","kid":"sso_oidc_key_pair_01","x5c":["MIIDQjCCAiqgAwIBAgIGATz"],"x5t#S256":"ZjQzYjk"}]}`
+
+func TestJWKSDataSource_UsesProviderClientID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/sso/jwks/client_123" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(jwksFixture))
+	}))
+	defer server.Close()
+
+	state := readJWKSDataSource(t, server.URL, "client_123", JWKSDataSourceModel{
+		ClientID: types.StringNull(),
+	})
+
+	if state.ClientID.ValueString() != "client_123" {
+		t.Fatalf("expected the provider client ID, got %s", state.ClientID.ValueString())
+	}
+	if state.URL.ValueString() != server.URL+"/sso/jwks/client_123" {
+		t.Fatalf("unexpected url: %s", state.URL.ValueString())
+	}
+	if state.JSON.ValueString() != jwksFixture {
+		t.Fatalf("expected the key set document, got %s", state.JSON.ValueString())
+	}
+	if len(state.Keys) != 1 {
+		t.Fatalf("expected 1 key, got %d", len(state.Keys))
+	}
+	key := state.Keys[0]
+	if key.Kid.ValueString() != "sso_oidc_key_pair_01" || key.Alg.ValueString() != "RS256" || key.X5TS256.ValueString() != "ZjQzYjk" {
+		t.Fatalf("unexpected key: %#v", key)
+	}
+	if len(key.X5C) != 1 || key.X5C[0].ValueString() != "MIIDQjCCAiqgAwIBAgIGATz" {
+		t.Fatalf("unexpected x5c: %v", key.X5C)
+	}
+}
+
+func TestJWKSDataSource_ConfiguredClientIDTakesPrecedence(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/sso/jwks/client_other" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"keys":[]}`))
+	}))
+	defer server.Close()
+
+	state := readJWKSDataSource(t, server.URL, "client_123", JWKSDataSourceModel{
+		ClientID: types.StringValue("client_other"),
+	})
+
+	if state.ClientID.ValueString() != "client_other" || len(state.Keys) != 0 {
+		t.Fatalf("unexpected state: %#v", state)
+	}
+}
+
+func readJWKSDataSource(t *testing.T, baseURL, clientID string, config JWKSDataSourceModel) JWKSDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", clientID, baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &JWKSDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &config)
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state JWKSDataSourceModel
+	diags = readResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+
+	return state
+}
@@ -32,14 +32,15 @@ type OrganizationDataSource struct {
 
 // OrganizationDataSourceModel describes the data source data model.
 type OrganizationDataSourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Domain     types.String `tfsdk:"domain"`
-	ExternalID types.String `tfsdk:"external_id"`
-	Name       types.String `tfsdk:"name"`
-	Domains    types.Set    `tfsdk:"domains"`
-	Metadata   types.Map    `tfsdk:"metadata"`
-	CreatedAt  types.String `tfsdk:"created_at"`
-	UpdatedAt  types.String `tfsdk:"updated_at"`
+	ID               types.String `tfsdk:"id"`
+	Domain           types.String `tfsdk:"domain"`
+	ExternalID       types.String `tfsdk:"external_id"`
+	StripeCustomerID types.String `tfsdk:"stripe_customer_id"`
+	Name             types.String `tfsdk:"name"`
+	Domains          types.Set    `tfsdk:"domains"`
+	Metadata         types.Map    `tfsdk:"metadata"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
 }
 
 func (d *OrganizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -52,7 +53,7 @@ func (d *OrganizationDataSource) Schema(ctx context.Context, req datasource.Sche
 		MarkdownDescription: `
 Use this data source to get information about a WorkOS Organization.
 
-You can look up an organization by its ID, domain, or external ID.
+You can look up an organization by its ID, domain, external ID, or name.
 
 ## Example Usage
 
@@ -79,6 +80,14 @@ data "workos_organization" "example" {
   external_id = "my-external-id"
 }
 ` + "```" + `
+
+### By Name
+
+` + "```hcl" + `
+data "workos_organization" "example" {
+  name = "Acme Corporation"
+}
+` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -99,8 +108,14 @@ data "workos_organization" "example" {
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				Description:         "The name of the organization.",
-				MarkdownDescription: "The name of the organization.",
+				Description:         "The name of the organization to look up.",
+				MarkdownDescription: "The name of the organization to look up. Must match exactly one organization; the lookup fails if several organizations share the name.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"stripe_customer_id": schema.StringAttribute{
+				Description:         "The Stripe customer ID associated with the organization.",
+				MarkdownDescription: "The Stripe customer ID associated with the organization.",
 				Computed:            true,
 			},
 			"metadata": schema.MapAttribute{
@@ -135,6 +150,7 @@ func (d *OrganizationDataSource) ConfigValidators(ctx context.Context) []datasou
 			path.MatchRoot("id"),
 			path.MatchRoot("domain"),
 			path.MatchRoot("external_id"),
+			path.MatchRoot("name"),
 		),
 	}
 }
@@ -159,6 +175,8 @@ func (d *OrganizationDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
 	var config OrganizationDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -213,6 +231,20 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 			)
 			return
 		}
+	} else if !config.Name.IsNull() {
+		// Look up by name
+		tflog.Debug(ctx, "Reading organization by name", map[string]any{
+			"name": config.Name.ValueString(),
+		})
+
+		org, err = d.client.GetOrganizationByName(ctx, config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Organization",
+				"Could not find organization with name "+config.Name.ValueString()+": "+err.Error(),
+			)
+			return
+		}
 	}
 
 	// Map response to state
@@ -228,6 +260,13 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		config.ExternalID = types.StringNull()
 	}
 
+	// Map stripe_customer_id
+	if org.StripeCustomerID != "" {
+		config.StripeCustomerID = types.StringValue(org.StripeCustomerID)
+	} else {
+		config.StripeCustomerID = types.StringNull()
+	}
+
 	// Map metadata
 	if len(org.Metadata) > 0 {
 		metadataMap, diags := types.MapValueFrom(ctx, types.StringType, org.Metadata)
@@ -0,0 +1,174 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganizationMembershipDataSource{}
+
+func NewOrganizationMembershipDataSource() datasource.DataSource {
+	return &OrganizationMembershipDataSource{}
+}
+
+// OrganizationMembershipDataSource defines the data source implementation.
+type OrganizationMembershipDataSource struct {
+	client *client.Client
+}
+
+// OrganizationMembershipDataSourceModel describes the data source data model.
+type OrganizationMembershipDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	UserID         types.String `tfsdk:"user_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	RoleSlug       types.String `tfsdk:"role_slug"`
+	Status         types.String `tfsdk:"status"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (d *OrganizationMembershipDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_membership"
+}
+
+func (d *OrganizationMembershipDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to look up a user's membership in a WorkOS Organization.",
+		MarkdownDescription: `
+Use this data source to look up a user's membership in a WorkOS Organization.
+
+This is useful for referencing memberships created outside of Terraform, for
+example by just-in-time provisioning or by another module.
+
+## Example Usage
+
+` + "```hcl" + `
+data "workos_organization_membership" "jane_acme" {
+  user_id         = data.workos_user.jane.id
+  organization_id = data.workos_organization.acme.id
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The unique identifier of the organization membership.",
+				MarkdownDescription: "The unique identifier of the organization membership (e.g., `om_01HXYZ...`).",
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				Description:         "The ID of the member user.",
+				MarkdownDescription: "The ID of the member user.",
+				Required:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description:         "The ID of the organization.",
+				MarkdownDescription: "The ID of the organization.",
+				Required:            true,
+			},
+			"role_slug": schema.StringAttribute{
+				Description:         "The slug of the role assigned to the user within the organization.",
+				MarkdownDescription: "The slug of the role assigned to the user within the organization (e.g., `admin`, `member`).",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				Description:         "The status of the membership.",
+				MarkdownDescription: "The status of the membership (`active`, `inactive`, `pending`).",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				Description:         "The timestamp when the membership was created.",
+				MarkdownDescription: "The timestamp when the membership was created (RFC3339 format).",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description:         "The timestamp when the membership was last updated.",
+				MarkdownDescription: "The timestamp when the membership was last updated (RFC3339 format).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrganizationMembershipDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationMembershipDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
+	var config OrganizationMembershipDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := config.UserID.ValueString()
+	organizationID := config.OrganizationID.ValueString()
+
+	tflog.Debug(ctx, "Reading organization membership", map[string]any{
+		"user_id":         userID,
+		"organization_id": organizationID,
+	})
+
+	memberships, err := d.client.ListOrganizationMemberships(ctx, userID, organizationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organization Membership",
+			"Could not list memberships for user "+userID+" in organization "+organizationID+": "+err.Error(),
+		)
+		return
+	}
+
+	var membership *client.OrganizationMembership
+	for i := range memberships.Data {
+		if memberships.Data[i].UserID == userID && memberships.Data[i].OrganizationID == organizationID {
+			membership = &memberships.Data[i]
+			break
+		}
+	}
+	if membership == nil {
+		resp.Diagnostics.AddError(
+			"Organization Membership Not Found",
+			fmt.Sprintf("User %s is not a member of organization %s.", userID, organizationID),
+		)
+		return
+	}
+
+	// Map response to state
+	config.ID = types.StringValue(membership.ID)
+	config.RoleSlug = optionalString(&membership.Role.Slug)
+	config.Status = types.StringValue(membership.Status)
+	config.CreatedAt = types.StringValue(membership.CreatedAt.Format(time.RFC3339))
+	config.UpdatedAt = types.StringValue(membership.UpdatedAt.Format(time.RFC3339))
+
+	tflog.Info(ctx, "Read organization membership", map[string]any{
+		"id": membership.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
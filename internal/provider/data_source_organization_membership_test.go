@@ -0,0 +1,125 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestOrganizationMembershipDataSource_ByUserAndOrganization(t *testing.T) {
+	server := httptest.NewServer(organizationMembershipHandler(t, `[
+  {"id":"om_123","user_id":"user_123","organization_id":"org_123","role":{"slug":"admin"},"status":"active","created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-16T12:00:00.000Z"}
+]`))
+	defer server.Close()
+
+	state, resp := readOrganizationMembershipDataSource(t, server.URL)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", resp.Diagnostics)
+	}
+
+	if state.ID.ValueString() != "om_123" {
+		t.Fatalf("unexpected ID: %s", state.ID.ValueString())
+	}
+	if state.RoleSlug.ValueString() != "admin" || state.Status.ValueString() != "active" {
+		t.Fatalf("unexpected role or status: %s %s", state.RoleSlug.ValueString(), state.Status.ValueString())
+	}
+	if state.UpdatedAt.ValueString() != "2026-01-16T12:00:00Z" {
+		t.Fatalf("unexpected updated_at: %s", state.UpdatedAt.ValueString())
+	}
+}
+
+func TestOrganizationMembershipDataSource_NotFound(t *testing.T) {
+	server := httptest.NewServer(organizationMembershipHandler(t, `[]`))
+	defer server.Close()
+
+	_, resp := readOrganizationMembershipDataSource(t, server.URL)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the user has no membership in the organization")
+	}
+}
+
+func organizationMembershipHandler(t *testing.T, memberships string) http.Handler {
+	t.Helper()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/user_management/organization_memberships" {
+			t.Fatalf("unexpected request path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("user_id"); got != "user_123" {
+			t.Fatalf("expected user_id=user_123, got %q", got)
+		}
+		if got := r.URL.Query().Get("organization_id"); got != "org_123" {
+			t.Fatalf("expected organization_id=org_123, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":` + memberships + `,"list_metadata":{}}`))
+	})
+}
+
+func readOrganizationMembershipDataSource(t *testing.T, baseURL string) (OrganizationMembershipDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &OrganizationMembershipDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	config := OrganizationMembershipDataSourceModel{
+		ID:             types.StringNull(),
+		UserID:         types.StringValue("user_123"),
+		OrganizationID: types.StringValue("org_123"),
+		RoleSlug:       types.StringNull(),
+		Status:         types.StringNull(),
+		CreatedAt:      types.StringNull(),
+		UpdatedAt:      types.StringNull(),
+	}
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &config)
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+
+	var state OrganizationMembershipDataSourceModel
+	if !readResp.Diagnostics.HasError() {
+		diags = readResp.State.Get(ctx, &state)
+		if diags.HasError() {
+			t.Fatalf("failed to read state: %v", diags)
+		}
+	}
+
+	return state, readResp
+}
@@ -163,6 +163,8 @@ func (d *OrganizationRoleDataSource) Configure(ctx context.Context, req datasour
 }
 
 func (d *OrganizationRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
 	var config OrganizationRoleDataSourceModel
 
 	// Read Terraform configuration data into the model
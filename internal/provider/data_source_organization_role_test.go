@@ -6,18 +6,18 @@ package provider
 import (
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccOrganizationRoleDataSource_BySlug(t *testing.T) {
-	orgName := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
-	slug := fmt.Sprintf("org-test-role-%d", time.Now().UnixNano())
+	orgName := testAccRandomName(t)
+	slug := "org-test-role-" + testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccOrganizationRoleDataSourceConfigBySlug(orgName, slug),
@@ -42,12 +42,13 @@ func TestAccOrganizationRoleDataSource_BySlug(t *testing.T) {
 }
 
 func TestAccOrganizationRoleDataSource_ByID(t *testing.T) {
-	orgName := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
-	slug := fmt.Sprintf("org-test-role-%d", time.Now().UnixNano())
+	orgName := testAccRandomName(t)
+	slug := "org-test-role-" + testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccOrganizationRoleDataSourceConfigByID(orgName, slug),
@@ -6,17 +6,17 @@ package provider
 import (
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccOrganizationDataSource_ByID(t *testing.T) {
-	name := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
+	name := testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccOrganizationDataSourceConfigByID(name),
@@ -37,12 +37,13 @@ func TestAccOrganizationDataSource_ByID(t *testing.T) {
 }
 
 func TestAccOrganizationDataSource_ByDomain(t *testing.T) {
-	name := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
-	domain := fmt.Sprintf("test-%d.example.com", time.Now().UnixNano())
+	name := testAccRandomName(t)
+	domain := "test-" + testAccRandomName(t) + ".example.com"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccOrganizationDataSourceConfigByDomain(name, domain),
@@ -59,12 +60,13 @@ func TestAccOrganizationDataSource_ByDomain(t *testing.T) {
 }
 
 func TestAccOrganizationDataSource_ByExternalID(t *testing.T) {
-	name := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
-	externalID := fmt.Sprintf("ext-%d", time.Now().UnixNano())
+	name := testAccRandomName(t)
+	externalID := "ext-" + testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccOrganizationDataSourceConfigByExternalID(name, externalID),
@@ -81,6 +83,28 @@ func TestAccOrganizationDataSource_ByExternalID(t *testing.T) {
 	})
 }
 
+func TestAccOrganizationDataSource_ByName(t *testing.T) {
+	name := testAccRandomName(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationDataSourceConfigByName(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.workos_organization.test", "id",
+						"workos_organization.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.workos_organization.test", "name", name),
+				),
+			},
+		},
+	})
+}
+
 func testAccOrganizationDataSourceConfigByID(name string) string {
 	return fmt.Sprintf(`
 resource "workos_organization" "test" {
@@ -122,3 +146,17 @@ data "workos_organization" "test" {
 }
 `, name, externalID)
 }
+
+func testAccOrganizationDataSourceConfigByName(name string) string {
+	return fmt.Sprintf(`
+resource "workos_organization" "test" {
+  name = %[1]q
+}
+
+data "workos_organization" "test" {
+  name = %[1]q
+
+  depends_on = [workos_organization.test]
+}
+`, name)
+}
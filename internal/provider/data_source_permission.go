@@ -123,6 +123,8 @@ func (d *PermissionDataSource) Configure(ctx context.Context, req datasource.Con
 }
 
 func (d *PermissionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
 	var config PermissionDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -0,0 +1,149 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PortalSetupLinkDataSource{}
+
+func NewPortalSetupLinkDataSource() datasource.DataSource {
+	return &PortalSetupLinkDataSource{}
+}
+
+// PortalSetupLinkDataSource defines the data source implementation.
+type PortalSetupLinkDataSource struct {
+	client *client.Client
+}
+
+// PortalSetupLinkDataSourceModel describes the data source data model.
+type PortalSetupLinkDataSourceModel struct {
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Intent         types.String `tfsdk:"intent"`
+	ReturnURL      types.String `tfsdk:"return_url"`
+	SuccessURL     types.String `tfsdk:"success_url"`
+	Link           types.String `tfsdk:"link"`
+}
+
+func (d *PortalSetupLinkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_portal_setup_link"
+}
+
+func (d *PortalSetupLinkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to generate a WorkOS Admin Portal setup link for an organization.",
+		MarkdownDescription: `
+Use this data source to generate a WorkOS Admin Portal setup link for an organization.
+
+A new link is generated on every read. Links expire five minutes after they are generated, so they
+are suited to values consumed during the same apply rather than stored for later.
+
+## Example Usage
+
+` + "```hcl" + `
+data "workos_portal_setup_link" "sso" {
+  organization_id = workos_organization.acme.id
+  intent          = "sso"
+  return_url      = "https://app.example.com/settings/sso"
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Description:         "The ID of the organization to generate the link for.",
+				MarkdownDescription: "The ID of the organization to generate the link for.",
+				Required:            true,
+			},
+			"intent": schema.StringAttribute{
+				Description:         "The Admin Portal flow to open. One of " + strings.Join(client.PortalIntents, ", ") + ".",
+				MarkdownDescription: "The Admin Portal flow to open. One of `" + strings.Join(client.PortalIntents, "`, `") + "`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.PortalIntents...),
+				},
+			},
+			"return_url": schema.StringAttribute{
+				Description:         "The URL the user is sent to when they leave the Admin Portal.",
+				MarkdownDescription: "The URL the user is sent to when they leave the Admin Portal.",
+				Optional:            true,
+			},
+			"success_url": schema.StringAttribute{
+				Description:         "The URL the user is sent to after completing the setup flow.",
+				MarkdownDescription: "The URL the user is sent to after completing the setup flow.",
+				Optional:            true,
+			},
+			"link": schema.StringAttribute{
+				Description:         "The generated Admin Portal link.",
+				MarkdownDescription: "The generated Admin Portal link. Anyone holding the link can configure the organization until it expires.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (d *PortalSetupLinkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PortalSetupLinkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
+	var config PortalSetupLinkDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkReq := &client.PortalLinkRequest{
+		Organization: config.OrganizationID.ValueString(),
+		Intent:       config.Intent.ValueString(),
+		ReturnURL:    config.ReturnURL.ValueString(),
+		SuccessURL:   config.SuccessURL.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Generating portal setup link", map[string]any{
+		"organization_id": linkReq.Organization,
+		"intent":          linkReq.Intent,
+	})
+
+	link, err := d.client.GeneratePortalLink(ctx, linkReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating Portal Link",
+			"Could not generate portal link for organization "+linkReq.Organization+": "+err.Error(),
+		)
+		return
+	}
+
+	config.Link = types.StringValue(link)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
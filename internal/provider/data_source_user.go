@@ -98,7 +98,7 @@ data "workos_user" "by_external_id" {
 			},
 			"email": schema.StringAttribute{
 				Description:         "The user's email address.",
-				MarkdownDescription: "The user's email address. Either `id`, `email`, or `external_id` must be specified.",
+				MarkdownDescription: "The user's email address. Either `id`, `email`, or `external_id` must be specified. Matched case-insensitively; the lookup fails if more than one user has the address.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -181,6 +181,8 @@ func (d *UserDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
 	var data UserDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -224,7 +226,10 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	// Map response to state
 	data.ID = types.StringValue(user.ID)
-	data.Email = types.StringValue(user.Email)
+	// Keep a configured email, which may differ from WorkOS only in case.
+	if data.Email.IsNull() || data.Email.ValueString() == "" {
+		data.Email = types.StringValue(user.Email)
+	}
 	data.EmailVerified = types.BoolValue(user.EmailVerified)
 	if user.FirstName != "" {
 		data.FirstName = types.StringValue(user.FirstName)
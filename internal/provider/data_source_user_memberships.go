@@ -0,0 +1,190 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserMembershipsDataSource{}
+
+func NewUserMembershipsDataSource() datasource.DataSource {
+	return &UserMembershipsDataSource{}
+}
+
+// UserMembershipsDataSource defines the data source implementation.
+type UserMembershipsDataSource struct {
+	client *client.Client
+}
+
+// UserMembershipsDataSourceModel describes the data source data model.
+type UserMembershipsDataSourceModel struct {
+	UserID          types.String                  `tfsdk:"user_id"`
+	OrganizationIDs []types.String                `tfsdk:"organization_ids"`
+	Memberships     []UserMembershipListItemModel `tfsdk:"memberships"`
+}
+
+// UserMembershipListItemModel describes a single organization membership in a
+// list.
+type UserMembershipListItemModel struct {
+	ID             types.String `tfsdk:"id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	RoleSlug       types.String `tfsdk:"role_slug"`
+	Status         types.String `tfsdk:"status"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (d *UserMembershipsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_memberships"
+}
+
+func (d *UserMembershipsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list every organization membership of a WorkOS user.",
+		MarkdownDescription: `
+Use this data source to list every organization membership of a WorkOS user.
+
+This is useful for offboarding, where access has to be revoked in each
+organization the user belongs to.
+
+## Example Usage
+
+` + "```hcl" + `
+data "workos_user" "leaver" {
+  email = "jane@example.com"
+}
+
+data "workos_user_memberships" "leaver" {
+  user_id = data.workos_user.leaver.id
+}
+
+output "leaver_active_organizations" {
+  value = [
+    for membership in data.workos_user_memberships.leaver.memberships :
+    membership.organization_id if membership.status == "active"
+  ]
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user whose memberships to list.",
+				Required:    true,
+			},
+			"organization_ids": schema.ListAttribute{
+				Description: "The IDs of the organizations the user is a member of.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"memberships": schema.ListNestedAttribute{
+				Description: "The user's organization memberships.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the organization membership.",
+							Computed:    true,
+						},
+						"organization_id": schema.StringAttribute{
+							Description: "The ID of the organization.",
+							Computed:    true,
+						},
+						"role_slug": schema.StringAttribute{
+							Description:         "The slug of the role assigned to the user within the organization.",
+							MarkdownDescription: "The slug of the role assigned to the user within the organization (e.g., `admin`, `member`).",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							Description:         "The status of the membership.",
+							MarkdownDescription: "The status of the membership (`active`, `inactive`, `pending`).",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp when the membership was created.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The timestamp when the membership was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserMembershipsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserMembershipsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
+	var config UserMembershipsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := config.UserID.ValueString()
+
+	tflog.Debug(ctx, "Listing user memberships", map[string]any{
+		"user_id": userID,
+	})
+
+	memberships, err := d.client.ListOrganizationMemberships(ctx, userID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User Memberships",
+			"Could not list memberships for user "+userID+": "+err.Error(),
+		)
+		return
+	}
+
+	config.OrganizationIDs = make([]types.String, 0, len(memberships.Data))
+	config.Memberships = make([]UserMembershipListItemModel, 0, len(memberships.Data))
+	for _, membership := range memberships.Data {
+		config.OrganizationIDs = append(config.OrganizationIDs, types.StringValue(membership.OrganizationID))
+		config.Memberships = append(config.Memberships, UserMembershipListItemModel{
+			ID:             types.StringValue(membership.ID),
+			OrganizationID: types.StringValue(membership.OrganizationID),
+			RoleSlug:       optionalString(&membership.Role.Slug),
+			Status:         types.StringValue(membership.Status),
+			CreatedAt:      types.StringValue(membership.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:      types.StringValue(membership.UpdatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	tflog.Info(ctx, "Read user memberships", map[string]any{
+		"user_id": userID,
+		"count":   len(config.Memberships),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestUserMembershipsDataSource_ListsEveryPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/user_management/organization_memberships" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("user_id"); got != "user_123" {
+			t.Fatalf("expected user_id=user_123, got %q", got)
+		}
+		if r.URL.Query().Has("organization_id") {
+			t.Fatalf("expected no organization filter, got %q", r.URL.Query().Get("organization_id"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("after") == "" {
+			_, _ = w.Write([]byte(`{"data":[
+  {"id":"om_1","user_id":"user_123","organization_id":"org_1","role":{"slug":"admin"},"status":"active","created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"}
+],"list_metadata":{"after":"om_1"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[
+  {"id":"om_2","user_id":"user_123","organization_id":"org_2","role":{"slug":""},"status":"inactive","created_at":"2026-02-15T12:00:00.000Z","updated_at":"2026-02-15T12:00:00.000Z"}
+],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	state := readUserMembershipsDataSource(t, server.URL, UserMembershipsDataSourceModel{
+		UserID: types.StringValue("user_123"),
+	})
+
+	if len(state.Memberships) != 2 {
+		t.Fatalf("expected 2 memberships, got %d", len(state.Memberships))
+	}
+	if state.Memberships[0].RoleSlug.ValueString() != "admin" || state.Memberships[1].Status.ValueString() != "inactive" {
+		t.Fatalf("unexpected memberships: %#v", state.Memberships)
+	}
+	if !state.Memberships[1].RoleSlug.IsNull() {
+		t.Fatalf("expected null role slug, got %s", state.Memberships[1].RoleSlug)
+	}
+	if len(state.OrganizationIDs) != 2 || state.OrganizationIDs[1].ValueString() != "org_2" {
+		t.Fatalf("unexpected organization IDs: %v", state.OrganizationIDs)
+	}
+}
+
+func readUserMembershipsDataSource(t *testing.T, baseURL string, config UserMembershipsDataSourceModel) UserMembershipsDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &UserMembershipsDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &config)
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state UserMembershipsDataSourceModel
+	diags = readResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+
+	return state
+}
@@ -0,0 +1,192 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserMFAFactorsDataSource{}
+
+func NewUserMFAFactorsDataSource() datasource.DataSource {
+	return &UserMFAFactorsDataSource{}
+}
+
+// UserMFAFactorsDataSource defines the data source implementation.
+type UserMFAFactorsDataSource struct {
+	client *client.Client
+}
+
+// UserMFAFactorsDataSourceModel describes the data source data model.
+type UserMFAFactorsDataSourceModel struct {
+	UserID      types.String                 `tfsdk:"user_id"`
+	MFAEnrolled types.Bool                   `tfsdk:"mfa_enrolled"`
+	Factors     []UserMFAFactorListItemModel `tfsdk:"factors"`
+}
+
+// UserMFAFactorListItemModel describes a single enrolled authentication factor.
+type UserMFAFactorListItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Type        types.String `tfsdk:"type"`
+	TOTPIssuer  types.String `tfsdk:"totp_issuer"`
+	TOTPUser    types.String `tfsdk:"totp_user"`
+	PhoneNumber types.String `tfsdk:"phone_number"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func (d *UserMFAFactorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_mfa_factors"
+}
+
+func (d *UserMFAFactorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the authentication factors enrolled for a WorkOS user.",
+		MarkdownDescription: `
+Use this data source to list the authentication factors enrolled for a WorkOS user.
+
+WorkOS does not report when a factor was last used, so only enrollment details are available.
+
+## Example Usage
+
+` + "```hcl" + `
+data "workos_user_mfa_factors" "jane" {
+  user_id = workos_user.jane.id
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Description:         "The ID of the user whose factors to list.",
+				MarkdownDescription: "The ID of the user whose factors to list.",
+				Required:            true,
+			},
+			"mfa_enrolled": schema.BoolAttribute{
+				Description:         "Whether the user has at least one enrolled factor.",
+				MarkdownDescription: "Whether the user has at least one enrolled factor.",
+				Computed:            true,
+			},
+			"factors": schema.ListNestedAttribute{
+				Description:         "The authentication factors enrolled for the user.",
+				MarkdownDescription: "The authentication factors enrolled for the user.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the factor.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The factor type, such as totp.",
+							Computed:    true,
+						},
+						"totp_issuer": schema.StringAttribute{
+							Description: "The TOTP issuer, for TOTP factors.",
+							Computed:    true,
+						},
+						"totp_user": schema.StringAttribute{
+							Description: "The TOTP account name, for TOTP factors.",
+							Computed:    true,
+						},
+						"phone_number": schema.StringAttribute{
+							Description: "The phone number, for SMS factors.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp when the factor was enrolled.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The timestamp when the factor was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserMFAFactorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserMFAFactorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer warnMaintenanceDelay(d.client, &resp.Diagnostics)
+
+	var config UserMFAFactorsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := config.UserID.ValueString()
+
+	tflog.Debug(ctx, "Listing authentication factors", map[string]any{
+		"user_id": userID,
+	})
+
+	factors, err := d.client.ListAuthenticationFactors(ctx, userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading MFA Factors",
+			"Could not list authentication factors for user "+userID+": "+err.Error(),
+		)
+		return
+	}
+
+	config.Factors = make([]UserMFAFactorListItemModel, 0, len(factors.Data))
+	for _, factor := range factors.Data {
+		item := UserMFAFactorListItemModel{
+			ID:          types.StringValue(factor.ID),
+			Type:        types.StringValue(factor.Type),
+			TOTPIssuer:  types.StringNull(),
+			TOTPUser:    types.StringNull(),
+			PhoneNumber: types.StringNull(),
+			CreatedAt:   types.StringValue(factor.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:   types.StringValue(factor.UpdatedAt.Format(time.RFC3339)),
+		}
+		if factor.TOTP != nil {
+			item.TOTPIssuer = optionalString(&factor.TOTP.Issuer)
+			item.TOTPUser = optionalString(&factor.TOTP.User)
+		}
+		if factor.SMS != nil {
+			item.PhoneNumber = optionalString(&factor.SMS.PhoneNumber)
+		}
+		config.Factors = append(config.Factors, item)
+	}
+	config.MFAEnrolled = types.BoolValue(len(config.Factors) > 0)
+
+	tflog.Info(ctx, "Read authentication factors", map[string]any{
+		"user_id": userID,
+		"count":   len(config.Factors),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestUserMFAFactorsDataSource_ListsFactors(t *testing.T) {
+	server := httptest.NewServer(userMFAFactorsHandler(t, `[
+  {"id":"auth_factor_1","type":"totp","user_id":"user_123","totp":{"issuer":"Acme","user":"jane@example.com"},"created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"}
+]`))
+	defer server.Close()
+
+	state := readUserMFAFactorsDataSource(t, server.URL)
+
+	if !state.MFAEnrolled.ValueBool() {
+		t.Fatal("expected mfa_enrolled to be true")
+	}
+	if len(state.Factors) != 1 {
+		t.Fatalf("expected 1 factor, got %d", len(state.Factors))
+	}
+	factor := state.Factors[0]
+	if factor.ID.ValueString() != "auth_factor_1" || factor.TOTPIssuer.ValueString() != "Acme" {
+		t.Fatalf("unexpected factor: %#v", factor)
+	}
+	if !factor.PhoneNumber.IsNull() {
+		t.Fatalf("expected null phone number, got %s", factor.PhoneNumber)
+	}
+}
+
+func TestUserMFAFactorsDataSource_NoFactors(t *testing.T) {
+	server := httptest.NewServer(userMFAFactorsHandler(t, `[]`))
+	defer server.Close()
+
+	state := readUserMFAFactorsDataSource(t, server.URL)
+
+	if state.MFAEnrolled.ValueBool() {
+		t.Fatal("expected mfa_enrolled to be false")
+	}
+	if state.Factors == nil || len(state.Factors) != 0 {
+		t.Fatalf("expected an empty factor list, got %#v", state.Factors)
+	}
+}
+
+func userMFAFactorsHandler(t *testing.T, data string) http.Handler {
+	t.Helper()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/user_management/users/user_123/auth_factors" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":` + data + `,"list_metadata":{}}`))
+	})
+}
+
+func readUserMFAFactorsDataSource(t *testing.T, baseURL string) UserMFAFactorsDataSourceModel {
+	t.Helper()
+
+	ctx := context.Background()
+	workosClient, err := client.NewClient("sk_test", "", baseURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	dataSource := &UserMFAFactorsDataSource{}
+	configureResp := &datasource.ConfigureResponse{}
+	dataSource.Configure(ctx, datasource.ConfigureRequest{ProviderData: workosClient}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	diags := configState.Set(ctx, &UserMFAFactorsDataSourceModel{
+		UserID:      types.StringValue("user_123"),
+		MFAEnrolled: types.BoolNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build config state: %v", diags)
+	}
+
+	readResp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    configState.Raw,
+			Schema: schemaResp.Schema,
+		},
+	}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state UserMFAFactorsDataSourceModel
+	diags = readResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("failed to read state: %v", diags)
+	}
+
+	return state
+}
@@ -6,17 +6,17 @@ package provider
 import (
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccUserDataSource_ByID(t *testing.T) {
-	email := fmt.Sprintf("tf-acc-ds-id-%d@example.com", time.Now().UnixNano())
+	email := "tf-acc-ds-id-" + testAccRandomName(t) + "@example.com"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccUserDataSourceConfig_ByID(email),
@@ -31,11 +31,12 @@ func TestAccUserDataSource_ByID(t *testing.T) {
 }
 
 func TestAccUserDataSource_ByEmail(t *testing.T) {
-	email := fmt.Sprintf("tf-acc-ds-email-%d@example.com", time.Now().UnixNano())
+	email := "tf-acc-ds-email-" + testAccRandomName(t) + "@example.com"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccUserDataSourceConfig_ByEmail(email),
@@ -77,12 +78,13 @@ data "workos_user" "test" {
 }
 
 func TestAccUserDataSource_ByExternalID(t *testing.T) {
-	email := fmt.Sprintf("tf-acc-ds-extid-%d@example.com", time.Now().UnixNano())
-	externalID := fmt.Sprintf("ext-ds-%d", time.Now().UnixNano())
+	email := "tf-acc-ds-extid-" + testAccRandomName(t) + "@example.com"
+	externalID := "ext-ds-" + testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccUserDataSourceConfig_ByExternalID(email, externalID),
@@ -112,11 +114,12 @@ data "workos_user" "test" {
 }
 
 func TestAccUserDataSource_WithMetadata(t *testing.T) {
-	email := fmt.Sprintf("tf-acc-ds-meta-%d@example.com", time.Now().UnixNano())
+	email := "tf-acc-ds-meta-" + testAccRandomName(t) + "@example.com"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccUserDataSourceConfig_WithMetadata(email),
@@ -0,0 +1,167 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Users and organizations keep the configured metadata in metadata and the
+// metadata actually sent to WorkOS, including the provider's default_metadata,
+// in the computed metadata_all. Only metadata_all is compared with WorkOS, so
+// keys inherited from the provider never show up as drift in metadata.
+
+// defaultMetadata returns the provider's default metadata, or nil when the
+// provider has not been configured yet.
+func defaultMetadata(c *client.Client) map[string]string {
+	if c == nil {
+		return nil
+	}
+	return c.DefaultMetadata()
+}
+
+// planMetadataAll plans metadata_all from the configured metadata and the
+// provider's default metadata. WorkOS bumps updated_at whenever metadata
+// changes, so updated_at becomes unknown when metadata_all does.
+func planMetadataAll(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var metadata types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("metadata"), &metadata)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if metadata.IsUnknown() {
+		// Computed metadata is unknown on create when it is not configured,
+		// in which case only the default metadata is sent.
+		var configured types.Map
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metadata"), &configured)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if configured.IsNull() {
+			metadata = configured
+		}
+	}
+
+	metadataAll, diags := mergedMetadata(ctx, defaultMetadata(c), metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("metadata_all"), metadataAll)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var priorMetadataAll types.Map
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("metadata_all"), &priorMetadataAll)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !metadataAll.Equal(priorMetadataAll) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("updated_at"), types.StringUnknown())...)
+	}
+}
+
+// mergedMetadata returns the provider's default metadata overlaid with the
+// configured metadata, or an unknown value when the configuration is unknown.
+func mergedMetadata(ctx context.Context, defaults map[string]string, metadata types.Map) (types.Map, diag.Diagnostics) {
+	if metadata.IsUnknown() {
+		return types.MapUnknown(types.StringType), nil
+	}
+
+	merged := make(map[string]string, len(defaults))
+	for key, value := range defaults {
+		merged[key] = value
+	}
+	if !metadata.IsNull() {
+		configured := make(map[string]string)
+		if diags := metadata.ElementsAs(ctx, &configured, false); diags.HasError() {
+			return types.MapNull(types.StringType), diags
+		}
+		for key, value := range configured {
+			merged[key] = value
+		}
+	}
+
+	return metadataValue(ctx, merged)
+}
+
+// configuredMetadata returns the metadata WorkOS reported without the keys
+// inherited from the provider's default metadata. A key is inherited when it
+// holds the default value and was not part of the prior metadata.
+func configuredMetadata(ctx context.Context, defaults map[string]string, remote map[string]string, prior types.Map) (types.Map, diag.Diagnostics) {
+	priorKeys := make(map[string]string)
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if diags := prior.ElementsAs(ctx, &priorKeys, false); diags.HasError() {
+			return types.MapNull(types.StringType), diags
+		}
+	}
+
+	configured := make(map[string]string, len(remote))
+	for key, value := range remote {
+		if defaultValue, inherited := defaults[key]; inherited && defaultValue == value {
+			if _, wasConfigured := priorKeys[key]; !wasConfigured {
+				continue
+			}
+		}
+		configured[key] = value
+	}
+
+	return metadataValue(ctx, configured)
+}
+
+// metadataValue converts metadata to a map value, null when empty.
+func metadataValue(ctx context.Context, metadata map[string]string) (types.Map, diag.Diagnostics) {
+	if len(metadata) == 0 {
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, metadata)
+}
+
+// metadataUpdate builds a metadata update from the planned and prior
+// metadata_all. WorkOS merges metadata on update, so keys that were removed
+// are sent as null.
+func metadataUpdate(ctx context.Context, planned, prior types.Map) (map[string]*string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	update := make(map[string]*string)
+
+	if !planned.IsNull() {
+		newMetadata := make(map[string]string)
+		diags.Append(planned.ElementsAs(ctx, &newMetadata, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for key, value := range newMetadata {
+			value := value
+			update[key] = &value
+		}
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		oldMetadata := make(map[string]string)
+		diags.Append(prior.ElementsAs(ctx, &oldMetadata, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for key := range oldMetadata {
+			if _, exists := update[key]; !exists {
+				update[key] = nil
+			}
+		}
+	}
+
+	return update, diags
+}
@@ -0,0 +1,170 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestConfiguredMetadata_StripsInheritedKeys(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"managed_by": "terraform", "team": "platform"}
+	remote := map[string]string{"managed_by": "terraform", "team": "identity", "tier": "gold"}
+	prior := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"tier": types.StringValue("gold"),
+	})
+
+	metadata, diags := configuredMetadata(ctx, defaults, remote, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	got := make(map[string]string)
+	metadata.ElementsAs(ctx, &got, false)
+	// team differs from the default so it was set outside the provider
+	// defaults and is reported as drift.
+	if len(got) != 2 || got["team"] != "identity" || got["tier"] != "gold" {
+		t.Fatalf("unexpected metadata: %v", got)
+	}
+}
+
+func TestConfiguredMetadata_KeepsConfiguredDefaultKeys(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"managed_by": "terraform"}
+	remote := map[string]string{"managed_by": "terraform"}
+	prior := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"managed_by": types.StringValue("terraform"),
+	})
+
+	metadata, diags := configuredMetadata(ctx, defaults, remote, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(metadata.Elements()) != 1 {
+		t.Fatalf("expected managed_by to be kept, got %v", metadata)
+	}
+
+	metadata, _ = configuredMetadata(ctx, defaults, remote, types.MapNull(types.StringType))
+	if !metadata.IsNull() {
+		t.Fatalf("expected null metadata when only defaults are set, got %v", metadata)
+	}
+}
+
+func TestMetadataUpdate_RemovesKeys(t *testing.T) {
+	ctx := context.Background()
+	planned := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"env": types.StringValue("prod"),
+	})
+	prior := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"env":   types.StringValue("dev"),
+		"stale": types.StringValue("value"),
+	})
+
+	update, diags := metadataUpdate(ctx, planned, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if update["env"] == nil || *update["env"] != "prod" {
+		t.Fatalf("expected env=prod, got %v", update["env"])
+	}
+	if value, exists := update["stale"]; !exists || value != nil {
+		t.Fatalf("expected stale to be sent as null, got %v", update)
+	}
+}
+
+func TestUserResourceModifyPlan_DefaultMetadataOnCreate(t *testing.T) {
+	ctx := context.Background()
+	c, err := client.NewClient("sk_test", "", "http://127.0.0.1")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	c.SetDefaultMetadata(map[string]string{"managed_by": "terraform"})
+	r := &UserResource{client: c}
+
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	config := testResourceState(t, r, map[string]tftypes.Value{
+		"email": tftypes.NewValue(tftypes.String, "jane@example.com"),
+	})
+	plan := testResourceState(t, r, map[string]tftypes.Value{
+		"email":        tftypes.NewValue(tftypes.String, "jane@example.com"),
+		"metadata":     tftypes.NewValue(mapType, tftypes.UnknownValue),
+		"metadata_all": tftypes.NewValue(mapType, tftypes.UnknownValue),
+	})
+
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State:  tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var planned UserResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &planned)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to read plan: %v", resp.Diagnostics)
+	}
+
+	metadataAll := make(map[string]string)
+	planned.MetadataAll.ElementsAs(ctx, &metadataAll, false)
+	if len(metadataAll) != 1 || metadataAll["managed_by"] != "terraform" {
+		t.Fatalf("unexpected metadata_all: %v", planned.MetadataAll)
+	}
+}
+
+func TestOrganizationResourceModifyPlan_ConfiguredMetadataOverridesDefaults(t *testing.T) {
+	ctx := context.Background()
+	c, err := client.NewClient("sk_test", "", "http://127.0.0.1")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	c.SetDefaultMetadata(map[string]string{"managed_by": "terraform", "env": "dev"})
+	r := &OrganizationResource{client: c}
+
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	metadata := tftypes.NewValue(mapType, map[string]tftypes.Value{
+		"env": tftypes.NewValue(tftypes.String, "prod"),
+	})
+	config := testResourceState(t, r, map[string]tftypes.Value{
+		"name":     tftypes.NewValue(tftypes.String, "Acme"),
+		"metadata": metadata,
+	})
+	plan := testResourceState(t, r, map[string]tftypes.Value{
+		"name":         tftypes.NewValue(tftypes.String, "Acme"),
+		"metadata":     metadata,
+		"metadata_all": tftypes.NewValue(mapType, tftypes.UnknownValue),
+	})
+
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State:  tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var planned OrganizationResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &planned)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to read plan: %v", resp.Diagnostics)
+	}
+
+	metadataAll := make(map[string]string)
+	planned.MetadataAll.ElementsAs(ctx, &metadataAll, false)
+	if len(metadataAll) != 2 || metadataAll["env"] != "prod" || metadataAll["managed_by"] != "terraform" {
+		t.Fatalf("unexpected metadata_all: %v", metadataAll)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// directorySyncPollInterval is how long to wait between lookups of a
+// directory object that has not been synced yet.
+var directorySyncPollInterval = 10 * time.Second
+
+// waitForDirectorySync looks up a directory object, repeating the lookup while
+// it is not found until the wait_for_results duration expires. The initial
+// SCIM sync of a new directory lags its creation, so objects looked up in the
+// same apply may not exist yet. A null duration looks the object up once.
+func waitForDirectorySync[T any](ctx context.Context, waitForResults types.String, lookup func() (T, error)) (T, error) {
+	result, err := lookup()
+	if err == nil || !client.IsNotFound(err) || waitForResults.IsNull() {
+		return result, err
+	}
+
+	timeout, parseErr := time.ParseDuration(waitForResults.ValueString())
+	if parseErr != nil {
+		return result, err
+	}
+	deadline := time.Now().Add(timeout)
+
+	for client.IsNotFound(err) && time.Now().Before(deadline) {
+		tflog.Debug(ctx, "Directory object not synced yet, retrying", map[string]any{
+			"remaining": time.Until(deadline).Round(time.Second).String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-time.After(directorySyncPollInterval):
+		}
+		result, err = lookup()
+	}
+
+	return result, err
+}
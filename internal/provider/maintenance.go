@@ -0,0 +1,33 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// warnMaintenanceDelay adds a warning when WorkOS maintenance delayed requests
+// since the last warning. Resources and data sources defer it in every
+// operation that calls the API, so the delay is reported in the plan or apply
+// output instead of only in the logs.
+func warnMaintenanceDelay(c *client.Client, diags *diag.Diagnostics) {
+	if c == nil {
+		return
+	}
+
+	responses, wait := c.TakeMaintenanceDelay()
+	if responses == 0 {
+		return
+	}
+
+	diags.AddWarning(
+		"WorkOS API Maintenance Delayed Requests",
+		fmt.Sprintf("WorkOS returned 503 Service Unavailable for scheduled maintenance, so the provider waited %s before retrying %d request(s). "+
+			"The operation completed once the API was available again.", wait.Round(time.Second), responses),
+	)
+}
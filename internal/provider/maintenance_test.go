@@ -0,0 +1,54 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestWarnMaintenanceDelayReportsEachDelayOnce(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"message":"Scheduled maintenance"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"org_123","name":"Acme"}`))
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	warnMaintenanceDelay(c, &diags)
+	if len(diags) != 0 {
+		t.Fatalf("expected no warning before any delay, got %v", diags)
+	}
+
+	if _, err := c.GetOrganization(context.Background(), "org_123"); err != nil {
+		t.Fatalf("GetOrganization returned error: %v", err)
+	}
+	warnMaintenanceDelay(c, &diags)
+	if len(diags) != 1 || diags[0].Severity() != diag.SeverityWarning || diags[0].Summary() != "WorkOS API Maintenance Delayed Requests" {
+		t.Fatalf("expected a maintenance warning, got %v", diags)
+	}
+
+	warnMaintenanceDelay(c, &diags)
+	if len(diags) != 1 {
+		t.Fatalf("expected the delay to be reported once, got %v", diags)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -33,21 +35,97 @@ func (m useStateForUnknownIfConfigUnchanged) PlanModifyString(ctx context.Contex
 		return
 	}
 
-	// Compare each tracked config attribute between plan and state.
+	if m.configChanged(ctx, req.Plan, req.State, &resp.Diagnostics) {
+		// Something changed — mark unknown so the API response value is accepted.
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+
+	// Nothing changed — keep the state value (no diff).
+	resp.PlanValue = req.StateValue
+}
+
+func (m useStateForUnknownIfConfigUnchanged) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if req.StateValue.IsNull() {
+		return
+	}
+
+	if m.configChanged(ctx, req.Plan, req.State, &resp.Diagnostics) {
+		resp.PlanValue = types.BoolUnknown()
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// configChanged reports whether any tracked config attribute differs between
+// plan and state.
+func (m useStateForUnknownIfConfigUnchanged) configChanged(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, diags *diag.Diagnostics) bool {
 	for _, attrPath := range m.configAttributes {
 		var planVal, stateVal attr.Value
-		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, attrPath, &planVal)...)
-		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attrPath, &stateVal)...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(plan.GetAttribute(ctx, attrPath, &planVal)...)
+		diags.Append(state.GetAttribute(ctx, attrPath, &stateVal)...)
+		if diags.HasError() {
+			return false
 		}
 		if !planVal.Equal(stateVal) {
-			// Something changed — mark unknown so the API response value is accepted.
-			resp.PlanValue = types.StringUnknown()
-			return
+			return true
 		}
 	}
+	return false
+}
+
+// emailVerifiedPlanModifier leaves a user's email_verified to WorkOS when
+// verify_email_change is enabled: the state value is kept, and a changed email
+// is planned as unverified until its owner confirms it. Otherwise it applies
+// email_verified_policy: ignore_upgrades keeps an address WorkOS has verified
+// verified, and ignore always keeps the state value.
+type emailVerifiedPlanModifier struct{}
+
+func (m emailVerifiedPlanModifier) Description(_ context.Context) string {
+	return "Tracks email verification from WorkOS when verify_email_change is enabled or email_verified_policy allows it."
+}
+
+func (m emailVerifiedPlanModifier) MarkdownDescription(_ context.Context) string {
+	return "Tracks email verification from WorkOS when `verify_email_change` is enabled or `email_verified_policy` allows it."
+}
+
+func (m emailVerifiedPlanModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if req.StateValue.IsNull() {
+		return
+	}
+
+	var verifyEmailChange types.Bool
+	var policy types.String
+	var planEmail, stateEmail types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("verify_email_change"), &verifyEmailChange)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("email_verified_policy"), &policy)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("email"), &planEmail)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("email"), &stateEmail)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.ConfigValue.IsNull() || !verifyEmailChange.ValueBool() {
+		switch policy.ValueString() {
+		case userEmailVerifiedPolicyIgnore:
+			resp.PlanValue = req.StateValue
+		case userEmailVerifiedPolicyIgnoreUpgrades:
+			if req.StateValue.ValueBool() && !resp.PlanValue.IsUnknown() {
+				resp.PlanValue = req.StateValue
+			}
+		}
+		return
+	}
+
+	if planEmail.IsUnknown() {
+		resp.PlanValue = types.BoolUnknown()
+		return
+	}
+	if !planEmail.Equal(stateEmail) {
+		resp.PlanValue = types.BoolValue(false)
+		return
+	}
 
-	// Nothing changed — keep the state value (no diff).
 	resp.PlanValue = req.StateValue
 }
@@ -6,12 +6,20 @@ package provider
 import (
 	"context"
 	"os"
-
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/osodevops/terraform-provider-workos/internal/client"
@@ -19,6 +27,7 @@ import (
 
 // Ensure WorkOSProvider satisfies various provider interfaces.
 var _ provider.Provider = &WorkOSProvider{}
+var _ provider.ProviderWithConfigValidators = &WorkOSProvider{}
 
 // WorkOSProvider defines the provider implementation.
 type WorkOSProvider struct {
@@ -26,13 +35,28 @@ type WorkOSProvider struct {
 	// provider is built and run locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// configureClient, when set, adjusts the API client after it has been
+	// configured. Acceptance tests use it to record and replay API traffic.
+	configureClient func(*client.Client)
 }
 
 // WorkOSProviderModel describes the provider data model.
 type WorkOSProviderModel struct {
-	APIKey   types.String `tfsdk:"api_key"`
-	ClientID types.String `tfsdk:"client_id"`
-	BaseURL  types.String `tfsdk:"base_url"`
+	APIKey              types.String `tfsdk:"api_key"`
+	APIKeyFile          types.String `tfsdk:"api_key_file"`
+	APIKeyCommand       types.List   `tfsdk:"api_key_command"`
+	ExpectedEnvironment types.String `tfsdk:"expected_environment"`
+	ClientID            types.String `tfsdk:"client_id"`
+	BaseURL             types.String `tfsdk:"base_url"`
+	RemoveOnForbidden   types.Bool   `tfsdk:"remove_on_forbidden"`
+	MaxIdleConnsPerHost types.Int64  `tfsdk:"max_idle_conns_per_host"`
+	BatchReads          types.Bool   `tfsdk:"batch_reads"`
+	DefaultMetadata     types.Map    `tfsdk:"default_metadata"`
+	ValidateReferences  types.Bool   `tfsdk:"validate_references"`
+	ClientLogLevel      types.String `tfsdk:"client_log_level"`
+	AuditLogPath        types.String `tfsdk:"audit_log_path"`
+	AuditLogCaller      types.String `tfsdk:"audit_log_caller"`
 }
 
 func (p *WorkOSProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -49,11 +73,15 @@ The WorkOS provider allows you to manage WorkOS resources through Terraform.
 
 ## Authentication
 
-The provider requires a WorkOS API key for authentication. You can provide this in three ways:
+The provider requires a WorkOS API key for authentication. You can provide it in any of these ways, listed in order of precedence:
 
 1. Set the ` + "`api_key`" + ` attribute in the provider configuration
-2. Set the ` + "`WORKOS_API_KEY`" + ` environment variable
-3. Use a combination of both (attribute takes precedence)
+2. Set ` + "`api_key_file`" + ` to a file containing the key, such as a mounted Kubernetes secret or a Vault agent template
+3. Set ` + "`api_key_command`" + ` to a credential helper that prints the key
+4. Set the ` + "`WORKOS_API_KEY`" + ` environment variable
+5. Set the ` + "`WORKOS_API_KEY_FILE`" + ` environment variable to a file containing the key
+
+Only one of ` + "`api_key`" + `, ` + "`api_key_file`" + ` and ` + "`api_key_command`" + ` may be set.
 
 ## Example Usage
 
@@ -77,6 +105,34 @@ resource "workos_organization" "example" {
 				Optional:  true,
 				Sensitive: true,
 			},
+			"api_key_file": schema.StringAttribute{
+				Description: "Path to a file containing the WorkOS API key. " +
+					"Can also be set via the WORKOS_API_KEY_FILE environment variable.",
+				MarkdownDescription: "Path to a file containing the WorkOS API key. Surrounding whitespace is ignored. " +
+					"Can also be set via the `WORKOS_API_KEY_FILE` environment variable.",
+				Optional: true,
+			},
+			"api_key_command": schema.ListAttribute{
+				Description:         "A credential helper command, as a program followed by its arguments, that prints the WorkOS API key to standard output.",
+				MarkdownDescription: "A credential helper command, as a program followed by its arguments, that prints the WorkOS API key to standard output. The command is run without a shell and must finish within 30 seconds.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"expected_environment": schema.StringAttribute{
+				Description: "The WorkOS environment the API key must belong to, production or sandbox. " +
+					"The provider fails before making any API calls when the key is for a different environment. " +
+					"Can also be set via the WORKOS_EXPECTED_ENVIRONMENT environment variable.",
+				MarkdownDescription: "The WorkOS environment the API key must belong to, `production` (`sk_live_` keys) or `sandbox` (`sk_test_` keys). " +
+					"The provider fails before making any API calls when the key is for a different environment. " +
+					"Can also be set via the `WORKOS_EXPECTED_ENVIRONMENT` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(environmentProduction, environmentSandbox),
+				},
+			},
 			"client_id": schema.StringAttribute{
 				Description: "The WorkOS Client ID. Required for certain operations. " +
 					"Can also be set via the WORKOS_CLIENT_ID environment variable.",
@@ -91,10 +147,101 @@ resource "workos_organization" "example" {
 					"Can also be set via the `WORKOS_BASE_URL` environment variable.",
 				Optional: true,
 			},
+			"remove_on_forbidden": schema.BoolAttribute{
+				Description: "Remove a resource from state, with a warning, when reading it returns 403 Forbidden instead of failing the refresh. " +
+					"Some WorkOS objects return 403 rather than 404 after deletion. Defaults to false. " +
+					"Can also be set via the WORKOS_REMOVE_ON_FORBIDDEN environment variable.",
+				MarkdownDescription: "Remove a resource from state, with a warning, when reading it returns 403 Forbidden instead of failing the refresh. " +
+					"Some WorkOS objects return 403 rather than 404 after deletion. Defaults to `false`. " +
+					"Can also be set via the `WORKOS_REMOVE_ON_FORBIDDEN` environment variable.",
+				Optional: true,
+			},
+			"max_idle_conns_per_host": schema.Int64Attribute{
+				Description: "The number of keep-alive connections to the WorkOS API kept open between requests. Defaults to 10. " +
+					"Raise it when running Terraform with a higher -parallelism. " +
+					"Can also be set via the WORKOS_MAX_IDLE_CONNS_PER_HOST environment variable.",
+				MarkdownDescription: "The number of keep-alive connections to the WorkOS API kept open between requests. Defaults to `10`. " +
+					"Raise it when running Terraform with a higher `-parallelism`. " +
+					"Can also be set via the `WORKOS_MAX_IDLE_CONNS_PER_HOST` environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"batch_reads": schema.BoolAttribute{
+				Description: "Serve refresh reads of users, organizations, connections and organization memberships from one list call per type instead of one request per resource. " +
+					"Speeds up plans of large states at the cost of listing every object of a type once. " +
+					"Each list is reused until the provider makes its next change, so a change made outside Terraform after the list call is not seen until then. Defaults to false. " +
+					"Can also be set via the WORKOS_BATCH_READS environment variable.",
+				MarkdownDescription: "Serve refresh reads of users, organizations, connections and organization memberships from one list call per type instead of one request per resource. " +
+					"Speeds up plans of large states at the cost of listing every object of a type once. " +
+					"Each list is reused until the provider makes its next change, so a change made outside Terraform after the list call is not seen until then. Defaults to `false`. " +
+					"Can also be set via the `WORKOS_BATCH_READS` environment variable.",
+				Optional: true,
+			},
+			"default_metadata": schema.MapAttribute{
+				Description: "Metadata merged into every workos_user and workos_organization managed by this provider. " +
+					"Keys set in a resource's metadata take precedence. The merged result is exposed as metadata_all.",
+				MarkdownDescription: "Metadata merged into every `workos_user` and `workos_organization` managed by this provider, " +
+					"for example `managed_by = \"terraform\"`. Keys set in a resource's `metadata` take precedence. " +
+					"The merged result is exposed as `metadata_all`, so inherited keys are not reported as drift in `metadata`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"validate_references": schema.BoolAttribute{
+				Description: "Check during plan that the organization_id and user_id values of new or changed resources, and the role slugs of organization memberships, refer to existing objects. " +
+					"Catches typos before apply at the cost of one request per referenced ID or organization. Defaults to false. " +
+					"Can also be set via the WORKOS_VALIDATE_REFERENCES environment variable.",
+				MarkdownDescription: "Check during plan that the `organization_id` and `user_id` values of new or changed resources, and the role slugs of organization memberships, refer to existing objects. " +
+					"Catches typos before apply at the cost of one request per referenced ID or organization. Defaults to `false`. " +
+					"Can also be set via the `WORKOS_VALIDATE_REFERENCES` environment variable.",
+				Optional: true,
+			},
+			"client_log_level": schema.StringAttribute{
+				Description: "The level of the workos_client log subsystem, which logs every WorkOS API request with its operation, attempt, status code and the resource type that made it. " +
+					"One of trace, debug, info, warn, error or off. Defaults to the provider's log level. " +
+					"Can also be set via the WORKOS_CLIENT_LOG_LEVEL environment variable. TF_LOG_PROVIDER_WORKOS_CLIENT takes precedence over both.",
+				MarkdownDescription: "The level of the `workos_client` log subsystem, which logs every WorkOS API request with its operation, attempt, status code and the resource type that made it. " +
+					"One of `trace`, `debug`, `info`, `warn`, `error` or `off`. Defaults to the provider's log level. " +
+					"Can also be set via the `WORKOS_CLIENT_LOG_LEVEL` environment variable. `TF_LOG_PROVIDER_WORKOS_CLIENT` takes precedence over both.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(client.LogLevels...),
+				},
+			},
+			"audit_log_path": schema.StringAttribute{
+				Description: "A file to append a JSON record to for every create, update or delete request the provider sends to WorkOS, " +
+					"with its timestamp, method, resource path, status code, request ID and caller. The path must be a file, as the provider's standard output is reserved for Terraform. " +
+					"Can also be set via the WORKOS_AUDIT_LOG_PATH environment variable.",
+				MarkdownDescription: "A file to append a JSON record to for every create, update or delete request the provider sends to WorkOS, " +
+					"with its timestamp, method, resource path, status code, request ID and caller. The path must be a file, as the provider's standard output is reserved for Terraform. " +
+					"Can also be set via the `WORKOS_AUDIT_LOG_PATH` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"audit_log_caller": schema.StringAttribute{
+				Description: "Who the records in audit_log_path are attributed to, such as a CI job or pipeline user. " +
+					"Defaults to the user and host the provider runs as. Can also be set via the WORKOS_AUDIT_LOG_CALLER environment variable.",
+				MarkdownDescription: "Who the records in `audit_log_path` are attributed to, such as a CI job or pipeline user. " +
+					"Defaults to the user and host the provider runs as. Can also be set via the `WORKOS_AUDIT_LOG_CALLER` environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
 
+func (p *WorkOSProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("api_key"),
+			path.MatchRoot("api_key_file"),
+			path.MatchRoot("api_key_command"),
+		),
+	}
+}
+
 func (p *WorkOSProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring WorkOS client")
 
@@ -112,8 +259,44 @@ func (p *WorkOSProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	clientID := os.Getenv("WORKOS_CLIENT_ID")
 	baseURL := os.Getenv("WORKOS_BASE_URL")
 
-	if !config.APIKey.IsNull() {
+	switch {
+	case !config.APIKey.IsNull():
 		apiKey = config.APIKey.ValueString()
+	case !config.APIKeyFile.IsNull():
+		key, err := readAPIKeyFile(config.APIKeyFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_file"),
+				"Unable to Read WorkOS API Key File",
+				err.Error(),
+			)
+		}
+		apiKey = key
+	case !config.APIKeyCommand.IsNull():
+		var args []string
+		resp.Diagnostics.Append(config.APIKeyCommand.ElementsAs(ctx, &args, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		key, err := runAPIKeyCommand(ctx, args)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_command"),
+				"Unable to Run WorkOS API Key Command",
+				err.Error(),
+			)
+		}
+		apiKey = key
+	case apiKey == "" && os.Getenv("WORKOS_API_KEY_FILE") != "":
+		key, err := readAPIKeyFile(os.Getenv("WORKOS_API_KEY_FILE"))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_file"),
+				"Invalid WORKOS_API_KEY_FILE Value",
+				"The API key could not be read from the file set in WORKOS_API_KEY_FILE: "+err.Error(),
+			)
+		}
+		apiKey = key
 	}
 
 	if !config.ClientID.IsNull() {
@@ -124,17 +307,127 @@ func (p *WorkOSProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		baseURL = config.BaseURL.ValueString()
 	}
 
+	removeOnForbidden := false
+	if value := os.Getenv("WORKOS_REMOVE_ON_FORBIDDEN"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("remove_on_forbidden"),
+				"Invalid WORKOS_REMOVE_ON_FORBIDDEN Value",
+				"The WORKOS_REMOVE_ON_FORBIDDEN environment variable must be true or false, got: "+value,
+			)
+		}
+		removeOnForbidden = parsed
+	}
+
+	if !config.RemoveOnForbidden.IsNull() {
+		removeOnForbidden = config.RemoveOnForbidden.ValueBool()
+	}
+
+	maxIdleConnsPerHost := client.DefaultMaxIdleConnsPerHost
+	if value := os.Getenv("WORKOS_MAX_IDLE_CONNS_PER_HOST"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_idle_conns_per_host"),
+				"Invalid WORKOS_MAX_IDLE_CONNS_PER_HOST Value",
+				"The WORKOS_MAX_IDLE_CONNS_PER_HOST environment variable must be a positive integer, got: "+value,
+			)
+		}
+		maxIdleConnsPerHost = parsed
+	}
+
+	if !config.MaxIdleConnsPerHost.IsNull() {
+		maxIdleConnsPerHost = int(config.MaxIdleConnsPerHost.ValueInt64())
+	}
+
+	batchReads := false
+	if value := os.Getenv("WORKOS_BATCH_READS"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("batch_reads"),
+				"Invalid WORKOS_BATCH_READS Value",
+				"The WORKOS_BATCH_READS environment variable must be true or false, got: "+value,
+			)
+		}
+		batchReads = parsed
+	}
+
+	if !config.BatchReads.IsNull() {
+		batchReads = config.BatchReads.ValueBool()
+	}
+
+	validateReferences := false
+	if value := os.Getenv("WORKOS_VALIDATE_REFERENCES"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("validate_references"),
+				"Invalid WORKOS_VALIDATE_REFERENCES Value",
+				"The WORKOS_VALIDATE_REFERENCES environment variable must be true or false, got: "+value,
+			)
+		}
+		validateReferences = parsed
+	}
+
+	if !config.ValidateReferences.IsNull() {
+		validateReferences = config.ValidateReferences.ValueBool()
+	}
+
+	clientLogLevel := os.Getenv("WORKOS_CLIENT_LOG_LEVEL")
+	if clientLogLevel != "" && !slices.Contains(client.LogLevels, strings.ToLower(clientLogLevel)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_log_level"),
+			"Invalid WORKOS_CLIENT_LOG_LEVEL Value",
+			"The WORKOS_CLIENT_LOG_LEVEL environment variable must be one of "+strings.Join(client.LogLevels, ", ")+", got: "+clientLogLevel,
+		)
+	}
+
+	if !config.ClientLogLevel.IsNull() {
+		clientLogLevel = config.ClientLogLevel.ValueString()
+	}
+
+	auditLogPath := os.Getenv("WORKOS_AUDIT_LOG_PATH")
+	if !config.AuditLogPath.IsNull() {
+		auditLogPath = config.AuditLogPath.ValueString()
+	}
+
+	auditLogCaller := os.Getenv("WORKOS_AUDIT_LOG_CALLER")
+	if !config.AuditLogCaller.IsNull() {
+		auditLogCaller = config.AuditLogCaller.ValueString()
+	}
+
 	// If API key is not configured, return an error
-	if apiKey == "" {
+	if apiKey == "" && !resp.Diagnostics.HasError() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
 			"Missing WorkOS API Key",
 			"The provider cannot create the WorkOS API client as there is a missing or empty value for the WorkOS API key. "+
-				"Set the api_key value in the configuration or use the WORKOS_API_KEY environment variable. "+
+				"Set api_key, api_key_file or api_key_command in the configuration, or use the WORKOS_API_KEY or WORKOS_API_KEY_FILE environment variables. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	expectedEnvironment := os.Getenv("WORKOS_EXPECTED_ENVIRONMENT")
+	if !config.ExpectedEnvironment.IsNull() {
+		expectedEnvironment = config.ExpectedEnvironment.ValueString()
+	}
+
+	if apiKey != "" && expectedEnvironment != "" {
+		if environment := apiKeyEnvironment(apiKey); environment != expectedEnvironment {
+			if environment == "" {
+				environment = "an unrecognised environment"
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expected_environment"),
+				"WorkOS API Key Environment Mismatch",
+				"The provider is configured to expect a "+expectedEnvironment+" API key, but the configured key is for "+environment+". "+
+					"Check that the right credentials are in use before applying.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -161,6 +454,36 @@ func (p *WorkOSProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 		return
 	}
+	workosClient.SetRemoveOnForbidden(removeOnForbidden)
+	if maxIdleConnsPerHost != client.DefaultMaxIdleConnsPerHost {
+		workosClient.SetMaxIdleConnsPerHost(maxIdleConnsPerHost)
+	}
+	if batchReads {
+		workosClient.EnableBatchReads()
+	}
+	workosClient.SetValidateReferences(validateReferences)
+	workosClient.SetLogLevel(clientLogLevel)
+	if auditLogPath != "" {
+		if err := workosClient.SetAuditLog(auditLogPath, auditLogCaller); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("audit_log_path"),
+				"Unable to Open Audit Log",
+				"Could not open the audit log "+auditLogPath+": "+err.Error(),
+			)
+			return
+		}
+	}
+	if p.configureClient != nil {
+		p.configureClient(workosClient)
+	}
+	if !config.DefaultMetadata.IsNull() && !config.DefaultMetadata.IsUnknown() {
+		defaultMetadata := make(map[string]string)
+		resp.Diagnostics.Append(config.DefaultMetadata.ElementsAs(ctx, &defaultMetadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		workosClient.SetDefaultMetadata(defaultMetadata)
+	}
 
 	// Make the WorkOS client available during DataSource and Resource
 	// type Configure methods.
@@ -174,17 +497,24 @@ func (p *WorkOSProvider) Resources(ctx context.Context) []func() resource.Resour
 	return []func() resource.Resource{
 		NewOrganizationResource,
 		NewOrganizationDomainResource,
+		NewOrganizationDomainVerificationResource,
 		NewUserResource,
+		NewUserMFAFactorResource,
 		NewOrganizationMembershipResource,
 		NewGroupResource,
 		NewGroupMembershipResource,
 		NewConnectApplicationResource,
+		NewConnectApplicationClientSecretResource,
 		NewEnvironmentRoleResource,
 		NewOrganizationRoleResource,
 		NewPermissionResource,
 		NewOrganizationRolePermissionResource,
 		NewAuthorizationResourceResource,
 		NewAuthorizationRoleAssignmentResource,
+		NewInvitationResource,
+		NewInvitationResendResource,
+		NewSessionRevocationResource,
+		NewAuditLogEventResource,
 	}
 }
 
@@ -192,13 +522,23 @@ func (p *WorkOSProvider) DataSources(ctx context.Context) []func() datasource.Da
 	return []func() datasource.DataSource{
 		NewOrganizationDataSource,
 		NewConnectionDataSource,
+		NewConnectionSAMLMetadataDataSource,
 		NewDirectoryDataSource,
 		NewDirectoryUserDataSource,
+		NewDirectoryUsersDataSource,
 		NewDirectoryGroupDataSource,
+		NewDirectoryGroupsDataSource,
 		NewUserDataSource,
+		NewUserMFAFactorsDataSource,
+		NewOrganizationMembershipDataSource,
+		NewUserMembershipsDataSource,
+		NewInvitationDataSource,
 		NewEnvironmentRoleDataSource,
 		NewOrganizationRoleDataSource,
 		NewPermissionDataSource,
+		NewPortalSetupLinkDataSource,
+		NewAuditLogActionsDataSource,
+		NewJWKSDataSource,
 	}
 }
 
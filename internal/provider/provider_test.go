@@ -4,23 +4,218 @@
 package provider
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
 )
 
-// testAccProtoV6ProviderFactories are used to instantiate a provider during
-// acceptance testing. The factory function will be invoked for every Terraform
-// CLI command executed to create a provider server to which the CLI can
-// reattach.
-var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"workos": providerserver.NewProtocol6WithError(New("test")()),
+// testAccCassetteModeEnv selects how acceptance tests reach WorkOS. When it
+// is unset they run against the live API. "record" runs them against the live
+// API and saves the traffic of each passing test to a cassette under
+// testdata/cassettes, and "replay" answers every request from the test's
+// cassette so the suite runs without credentials.
+const testAccCassetteModeEnv = "WORKOS_CASSETTE_MODE"
+
+// testAccCassettes holds the cassette of each running acceptance test, keyed
+// by test name.
+var testAccCassettes sync.Map
+
+// testAccProviderFactories returns the factories used to instantiate the
+// provider during an acceptance test. The factory function will be invoked
+// for every Terraform CLI command executed to create a provider server to
+// which the CLI can reattach.
+func testAccProviderFactories(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
+
+	p := &WorkOSProvider{version: "test"}
+	if cassette := testAccCassette(t); cassette != nil {
+		p.configureClient = func(c *client.Client) {
+			c.WrapTransport(cassette.Transport)
+		}
+	}
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"workos": providerserver.NewProtocol6WithError(p),
+	}
 }
 
 func testAccPreCheck(t *testing.T) {
+	if os.Getenv(testAccCassetteModeEnv) == "replay" {
+		// Replayed requests never reach WorkOS, but the provider still
+		// requires a key to be configured.
+		if os.Getenv("WORKOS_API_KEY") == "" {
+			t.Setenv("WORKOS_API_KEY", "sk_test_replay")
+		}
+		return
+	}
+
 	if v := os.Getenv("WORKOS_API_KEY"); v == "" {
 		t.Fatal("WORKOS_API_KEY must be set for acceptance tests")
 	}
 }
+
+// testAccRandomName returns a random name for the objects an acceptance test
+// creates. Names are recorded in the test's cassette, so a replayed test
+// sends the same requests as the recording.
+func testAccRandomName(t *testing.T) string {
+	t.Helper()
+
+	generate := func() string {
+		const letters = "abcdefghijklmnopqrstuvwxyz"
+		suffix := make([]byte, 10)
+		for i := range suffix {
+			suffix[i] = letters[rand.Intn(len(letters))]
+		}
+		return "tfacc-" + string(suffix)
+	}
+
+	if cassette := testAccCassette(t); cassette != nil {
+		return cassette.Value(generate)
+	}
+	return generate()
+}
+
+// testAccCheckDestroy returns a CheckDestroy function that looks up every
+// object left in the final state and fails if WorkOS still has it.
+func testAccCheckDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		c, err := client.NewClient(os.Getenv("WORKOS_API_KEY"), os.Getenv("WORKOS_CLIENT_ID"), os.Getenv("WORKOS_BASE_URL"))
+		if err != nil {
+			return err
+		}
+		if cassette := testAccCassette(t); cassette != nil {
+			c.WrapTransport(cassette.Transport)
+		}
+
+		ctx := context.Background()
+		for name, rs := range s.RootModule().Resources {
+			if strings.HasPrefix(name, "data.") || rs.Primary == nil {
+				continue
+			}
+			attributes := rs.Primary.Attributes
+
+			var err error
+			switch rs.Type {
+			case "workos_organization":
+				_, err = c.GetOrganization(ctx, rs.Primary.ID)
+			case "workos_user":
+				_, err = c.GetUser(ctx, rs.Primary.ID)
+			case "workos_organization_membership":
+				_, err = c.GetOrganizationMembership(ctx, rs.Primary.ID)
+			case "workos_organization_role":
+				_, err = c.GetOrganizationRole(ctx, attributes["organization_id"], attributes["slug"])
+			case "workos_permission":
+				_, err = c.GetPermission(ctx, attributes["slug"])
+			case "workos_organization_role_permission":
+				var role *client.OrganizationRole
+				role, err = c.GetOrganizationRole(ctx, attributes["organization_id"], attributes["role_slug"])
+				if err == nil && !slices.Contains(role.Permissions, attributes["permission"]) {
+					continue
+				}
+			default:
+				continue
+			}
+
+			if err == nil {
+				return fmt.Errorf("%s (%s) still exists", name, rs.Primary.ID)
+			}
+			if !client.IsNotFound(err) {
+				return fmt.Errorf("error checking that %s (%s) was destroyed: %w", name, rs.Primary.ID, err)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCassette returns the cassette of the running acceptance test, or nil
+// when the test runs against the live API without recording.
+func testAccCassette(t *testing.T) *client.Cassette {
+	t.Helper()
+
+	mode := os.Getenv(testAccCassetteModeEnv)
+	if mode == "" {
+		return nil
+	}
+	if cassette, ok := testAccCassettes.Load(t.Name()); ok {
+		return cassette.(*client.Cassette)
+	}
+
+	path := filepath.Join("testdata", "cassettes", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+
+	var cassette *client.Cassette
+	switch mode {
+	case "record":
+		cassette = client.NewCassetteRecorder()
+		t.Cleanup(func() {
+			if t.Failed() {
+				t.Logf("not saving the cassette of failed test %s", t.Name())
+				return
+			}
+			if err := cassette.Save(path); err != nil {
+				t.Errorf("failed to save cassette %s: %v", path, err)
+			}
+		})
+	case "replay":
+		// A missing cassette fails the test rather than skipping it, so a
+		// replay run cannot pass without exercising anything.
+		loaded, err := client.LoadCassette(path)
+		if errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("no cassette at %s; run the test with %s=record against a sandbox environment and commit the result", path, testAccCassetteModeEnv)
+		}
+		if err != nil {
+			t.Fatalf("failed to load cassette: %v", err)
+		}
+		cassette = loaded
+	default:
+		t.Fatalf("%s must be record or replay, got %q", testAccCassetteModeEnv, mode)
+	}
+
+	testAccCassettes.Store(t.Name(), cassette)
+	t.Cleanup(func() { testAccCassettes.Delete(t.Name()) })
+	return cassette
+}
+
+// testResourceState builds a state for r's schema where every attribute not
+// present in values is null. The Schema and Raw fields can be reused to build
+// a tfsdk.Plan or tfsdk.Config for unit tests.
+func testResourceState(t *testing.T, r resource.Resource, values map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+			continue
+		}
+		attributes[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// isGoneOnRead reports whether an error returned while reading a resource
+// means it should be removed from state. A 404 always does. A 403 does only
+// when the provider's remove_on_forbidden option is enabled, in which case a
+// warning is added so the removal is not silent.
+func isGoneOnRead(c *client.Client, err error, diags *diag.Diagnostics) bool {
+	if client.IsNotFound(err) {
+		return true
+	}
+
+	if c != nil && c.RemoveOnForbidden() && client.IsForbidden(err) {
+		diags.AddWarning(
+			"Resource Removed After Forbidden Response",
+			"WorkOS returned 403 Forbidden while reading this resource, so it has been removed from state because remove_on_forbidden is enabled. "+
+				"If the API key has lost access rather than the object being deleted, restore the key's permissions and import the resource again.\n\n"+
+				"WorkOS Error: "+err.Error(),
+		)
+		return true
+	}
+
+	return false
+}
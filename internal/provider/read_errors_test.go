@@ -0,0 +1,60 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestIsGoneOnRead(t *testing.T) {
+	testCases := map[string]struct {
+		err               error
+		removeOnForbidden bool
+		expected          bool
+		expectWarning     bool
+	}{
+		"not found": {
+			err:      fmt.Errorf("failed to get organization: %w", &client.APIError{StatusCode: http.StatusNotFound}),
+			expected: true,
+		},
+		"forbidden by default": {
+			err:      &client.APIError{StatusCode: http.StatusForbidden},
+			expected: false,
+		},
+		"forbidden with remove_on_forbidden": {
+			err:               fmt.Errorf("failed to get organization: %w", &client.APIError{StatusCode: http.StatusForbidden}),
+			removeOnForbidden: true,
+			expected:          true,
+			expectWarning:     true,
+		},
+		"server error with remove_on_forbidden": {
+			err:               &client.APIError{StatusCode: http.StatusInternalServerError},
+			removeOnForbidden: true,
+			expected:          false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := client.NewClient("sk_test", "", "http://localhost")
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			c.SetRemoveOnForbidden(tc.removeOnForbidden)
+
+			var diags diag.Diagnostics
+			if got := isGoneOnRead(c, tc.err, &diags); got != tc.expected {
+				t.Fatalf("expected %t, got %t", tc.expected, got)
+			}
+			if hasWarning := diags.WarningsCount() > 0; hasWarning != tc.expectWarning {
+				t.Fatalf("expected warning=%t, got diagnostics: %v", tc.expectWarning, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// referenceKinds maps the attributes that reference other WorkOS objects to
+// the name of the object, used in diagnostics, and a lookup for it.
+var referenceKinds = map[string]struct {
+	name   string
+	lookup func(ctx context.Context, c *client.Client, id string) error
+}{
+	"organization_id": {
+		name: "Organization",
+		lookup: func(ctx context.Context, c *client.Client, id string) error {
+			_, err := c.GetOrganization(ctx, id)
+			return err
+		},
+	},
+	"user_id": {
+		name: "User",
+		lookup: func(ctx context.Context, c *client.Client, id string) error {
+			_, err := c.GetUser(ctx, id)
+			return err
+		},
+	},
+}
+
+// validateReferences checks, when the provider's validate_references option
+// is enabled, that the objects referenced by the given attributes exist, so a
+// mistyped ID fails the plan instead of part way through an apply. Only IDs
+// known at plan time that are new or changed are looked up; IDs of objects
+// created in the same apply are unknown and are left to the API.
+func validateReferences(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attributes ...string) {
+	if c == nil || !c.ValidateReferences() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, attribute := range attributes {
+		kind, ok := referenceKinds[attribute]
+		if !ok {
+			continue
+		}
+
+		var planned types.String
+		diags := req.Plan.GetAttribute(ctx, path.Root(attribute), &planned)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		if planned.IsNull() || planned.IsUnknown() {
+			continue
+		}
+
+		if !req.State.Raw.IsNull() {
+			var current types.String
+			diags := req.State.GetAttribute(ctx, path.Root(attribute), &current)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				return
+			}
+			if current.Equal(planned) {
+				continue
+			}
+		}
+
+		err := kind.lookup(ctx, c, planned.ValueString())
+		switch {
+		case err == nil:
+		case client.IsNotFound(err):
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				kind.name+" Not Found",
+				"No "+strings.ToLower(kind.name)+" with ID "+planned.ValueString()+" exists in this WorkOS environment. "+
+					"Check the ID, or reference the resource that manages the object instead of a literal ID.",
+			)
+		default:
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root(attribute),
+				"Unable to Verify "+kind.name,
+				"The reference could not be checked during plan and will be validated by WorkOS during apply.\n\n"+
+					"WorkOS Error: "+err.Error(),
+			)
+		}
+	}
+}
+
+// validateRoleReferences checks, when the provider's validate_references
+// option is enabled, that the role_slug and role_slugs of an organization
+// membership name roles available in its organization, which include the
+// environment's roles. Otherwise WorkOS only rejects an unknown role with a
+// 422 during apply.
+func validateRoleReferences(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if c == nil || !c.ValidateReferences() || req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state OrganizationMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	if plan.OrganizationID.IsNull() || plan.OrganizationID.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		diags := req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		if state.OrganizationID.Equal(plan.OrganizationID) && state.RoleSlug.Equal(plan.RoleSlug) && state.RoleSlugs.Equal(plan.RoleSlugs) {
+			return
+		}
+	}
+
+	// Slugs that are unknown until apply are left to the API.
+	slugs := map[string]path.Path{}
+	if !plan.RoleSlug.IsNull() && !plan.RoleSlug.IsUnknown() {
+		slugs[plan.RoleSlug.ValueString()] = path.Root("role_slug")
+	}
+	if !plan.RoleSlugs.IsNull() && !plan.RoleSlugs.IsUnknown() {
+		for i, element := range plan.RoleSlugs.Elements() {
+			if slug, ok := element.(types.String); ok && !slug.IsNull() && !slug.IsUnknown() {
+				slugs[slug.ValueString()] = path.Root("role_slugs").AtListIndex(i)
+			}
+		}
+	}
+	if len(slugs) == 0 {
+		return
+	}
+
+	organizationID := plan.OrganizationID.ValueString()
+	roles, err := c.ListOrganizationRoles(ctx, organizationID)
+	if err != nil {
+		// A missing organization is reported against organization_id.
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("organization_id"),
+				"Unable to Verify Roles",
+				"The roles could not be checked during plan and will be validated by WorkOS during apply.\n\n"+
+					"WorkOS Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	available := make([]string, 0, len(roles.Data))
+	exists := make(map[string]bool, len(roles.Data))
+	for _, role := range roles.Data {
+		available = append(available, role.Slug)
+		exists[role.Slug] = true
+	}
+	sort.Strings(available)
+
+	for slug, attribute := range slugs {
+		if exists[slug] {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			attribute,
+			"Role Not Found",
+			fmt.Sprintf("No role with slug %q is available in organization %s. Available roles: %s.", slug, organizationID, strings.Join(available, ", ")),
+		)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestValidateReferences(t *testing.T) {
+	testCases := map[string]struct {
+		enabled        bool
+		organizationID tftypes.Value
+		existing       bool
+		expectRequests []string
+		expectError    bool
+	}{
+		"disabled": {
+			organizationID: tftypes.NewValue(tftypes.String, "org_missing"),
+		},
+		"existing references": {
+			enabled:        true,
+			organizationID: tftypes.NewValue(tftypes.String, "org_123"),
+			expectRequests: []string{"GET /organizations/org_123", "GET /user_management/users/user_123"},
+		},
+		"missing organization": {
+			enabled:        true,
+			organizationID: tftypes.NewValue(tftypes.String, "org_missing"),
+			expectRequests: []string{"GET /organizations/org_missing", "GET /user_management/users/user_123"},
+			expectError:    true,
+		},
+		"unknown organization": {
+			enabled:        true,
+			organizationID: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectRequests: []string{"GET /user_management/users/user_123"},
+		},
+		"unchanged references": {
+			enabled:        true,
+			organizationID: tftypes.NewValue(tftypes.String, "org_missing"),
+			existing:       true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/organizations/org_123":
+					_, _ = w.Write([]byte(`{"id":"org_123","name":"Acme"}`))
+				case "/user_management/users/user_123":
+					_, _ = w.Write([]byte(`{"id":"user_123","email":"jane@example.com"}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message":"Not found"}`))
+				}
+			}))
+			defer server.Close()
+
+			c, err := client.NewClient("sk_test", "", server.URL)
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			c.SetValidateReferences(tc.enabled)
+			r := &OrganizationMembershipResource{client: c}
+
+			values := map[string]tftypes.Value{
+				"user_id":         tftypes.NewValue(tftypes.String, "user_123"),
+				"organization_id": tc.organizationID,
+			}
+			config := testResourceState(t, r, values)
+			plan := tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}
+			state := tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(config.Raw.Type(), nil)}
+			if tc.existing {
+				values["id"] = tftypes.NewValue(tftypes.String, "om_123")
+				state = testResourceState(t, r, values)
+				plan = tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+			}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				Plan:   plan,
+				State:  state,
+			}, resp)
+
+			if fmt.Sprint(requests) != fmt.Sprint(tc.expectRequests) {
+				t.Fatalf("expected requests %v, got %v", tc.expectRequests, requests)
+			}
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%t, got %v", tc.expectError, resp.Diagnostics)
+			}
+			if tc.expectError && !resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path().Equal(path.Root("organization_id")) {
+				t.Fatalf("expected the error on organization_id, got %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateRoleReferences(t *testing.T) {
+	testCases := map[string]struct {
+		values       map[string]tftypes.Value
+		expectErrors []path.Path
+	}{
+		"existing role": {
+			values: map[string]tftypes.Value{
+				"role_slug": tftypes.NewValue(tftypes.String, "admin"),
+			},
+		},
+		"missing role": {
+			values: map[string]tftypes.Value{
+				"role_slug": tftypes.NewValue(tftypes.String, "admn"),
+			},
+			expectErrors: []path.Path{path.Root("role_slug")},
+		},
+		"missing role in list": {
+			values: map[string]tftypes.Value{
+				"role_slugs": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "member"),
+					tftypes.NewValue(tftypes.String, "billing"),
+				}),
+			},
+			expectErrors: []path.Path{path.Root("role_slugs").AtListIndex(1)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/authorization/organizations/org_123/roles" {
+					t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":[{"id":"role_1","slug":"member","type":"EnvironmentRole"},{"id":"role_2","slug":"admin","type":"OrganizationRole"}],"list_metadata":{}}`))
+			}))
+			defer server.Close()
+
+			c, err := client.NewClient("sk_test", "", server.URL)
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			c.SetValidateReferences(true)
+
+			values := map[string]tftypes.Value{
+				"user_id":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"organization_id": tftypes.NewValue(tftypes.String, "org_123"),
+			}
+			for attribute, value := range tc.values {
+				values[attribute] = value
+			}
+			config := testResourceState(t, &OrganizationMembershipResource{}, values)
+			plan := tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			validateRoleReferences(context.Background(), c, resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				Plan:   plan,
+				State:  tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(config.Raw.Type(), nil)},
+			}, resp)
+
+			var errors []path.Path
+			for _, d := range resp.Diagnostics.Errors() {
+				errors = append(errors, d.(diag.DiagnosticWithPath).Path())
+			}
+			if fmt.Sprint(errors) != fmt.Sprint(tc.expectErrors) {
+				t.Fatalf("expected errors on %v, got %v", tc.expectErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+var _ resource.Resource = &AuditLogEventResource{}
+var _ resource.ResourceWithUpgradeState = &AuditLogEventResource{}
+
+func NewAuditLogEventResource() resource.Resource {
+	return &AuditLogEventResource{}
+}
+
+// AuditLogEventResource publishes an audit log event when it is created.
+// Audit log events are immutable, so destroying the resource only removes it
+// from state and changing any argument publishes a new event.
+type AuditLogEventResource struct {
+	client *client.Client
+}
+
+type AuditLogEventResourceModel struct {
+	ID               types.String               `tfsdk:"id"`
+	OrganizationID   types.String               `tfsdk:"organization_id"`
+	Action           types.String               `tfsdk:"action"`
+	OccurredAt       types.String               `tfsdk:"occurred_at"`
+	Version          types.Int64                `tfsdk:"version"`
+	ActorID          types.String               `tfsdk:"actor_id"`
+	ActorType        types.String               `tfsdk:"actor_type"`
+	ActorName        types.String               `tfsdk:"actor_name"`
+	Targets          []AuditLogEventTargetModel `tfsdk:"targets"`
+	ContextLocation  types.String               `tfsdk:"context_location"`
+	ContextUserAgent types.String               `tfsdk:"context_user_agent"`
+	Metadata         types.Map                  `tfsdk:"metadata"`
+	Triggers         types.Map                  `tfsdk:"triggers"`
+}
+
+type AuditLogEventTargetModel struct {
+	ID   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *AuditLogEventResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log_event"
+}
+
+func (r *AuditLogEventResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     auditLogEventSchemaVersion,
+		Description: "Publishes a WorkOS audit log event for an organization when created. Events are immutable: destroying the resource does not remove the event, and changing any argument publishes a new one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "An identifier for the published event, made of the organization ID, action and occurred_at.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the organization the event belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "The event action, for example terraform.apply.completed. The action must be configured in the WorkOS Dashboard.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"occurred_at": schema.StringAttribute{
+				Description: "When the event occurred, in RFC3339 format. Defaults to the time the event is published.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Description: "The schema version of the action, when the action has more than one version.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"actor_id": schema.StringAttribute{
+				Description: "The ID of the actor that performed the action.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"actor_type": schema.StringAttribute{
+				Description: "The type of the actor that performed the action.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"actor_name": schema.StringAttribute{
+				Description: "The display name of the actor.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"targets": schema.ListNestedAttribute{
+				Description: "The objects affected by the action.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the target.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the target.",
+							Required:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The display name of the target.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"context_location": schema.StringAttribute{
+				Description: "The IP address or location the action was performed from.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"context_user_agent": schema.StringAttribute{
+				Description: "The user agent the action was performed with.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Additional key-value pairs recorded with the event.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that publish a new event when they change.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AuditLogEventResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *AuditLogEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var plan AuditLogEventResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	occurredAt := time.Now().UTC()
+	if !plan.OccurredAt.IsNull() && !plan.OccurredAt.IsUnknown() {
+		parsed, err := time.Parse(time.RFC3339, plan.OccurredAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("occurred_at"),
+				"Invalid Occurred At",
+				"occurred_at must be an RFC3339 timestamp: "+err.Error(),
+			)
+			return
+		}
+		occurredAt = parsed
+	}
+
+	event := client.AuditLogEvent{
+		Action:     plan.Action.ValueString(),
+		OccurredAt: occurredAt,
+		Version:    plan.Version.ValueInt64(),
+		Actor: client.AuditLogActor{
+			ID:   plan.ActorID.ValueString(),
+			Type: plan.ActorType.ValueString(),
+			Name: plan.ActorName.ValueString(),
+		},
+		Context: client.AuditLogContext{
+			Location:  plan.ContextLocation.ValueString(),
+			UserAgent: plan.ContextUserAgent.ValueString(),
+		},
+	}
+	for _, target := range plan.Targets {
+		event.Targets = append(event.Targets, client.AuditLogTarget{
+			ID:   target.ID.ValueString(),
+			Type: target.Type.ValueString(),
+			Name: target.Name.ValueString(),
+		})
+	}
+	if !plan.Metadata.IsNull() && !plan.Metadata.IsUnknown() {
+		resp.Diagnostics.Append(plan.Metadata.ElementsAs(ctx, &event.Metadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := r.client.CreateAuditLogEvent(ctx, &client.AuditLogEventCreateRequest{
+		OrganizationID: plan.OrganizationID.ValueString(),
+		Event:          event,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Audit Log Event", "Could not publish audit log event: "+err.Error())
+		return
+	}
+
+	plan.OccurredAt = types.StringValue(occurredAt.Format(time.RFC3339))
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", plan.OrganizationID.ValueString(), plan.Action.ValueString(), plan.OccurredAt.ValueString()))
+
+	tflog.Info(ctx, "Published audit log event", map[string]any{"id": plan.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AuditLogEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AuditLogEventResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AuditLogEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AuditLogEventResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AuditLogEventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AuditLogEventResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removing audit log event from state; published events cannot be deleted", map[string]any{"id": state.ID.ValueString()})
+}
+
+func (r *AuditLogEventResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
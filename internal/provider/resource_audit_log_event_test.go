@@ -0,0 +1,120 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func createAuditLogEventForTest(t *testing.T, occurredAt tftypes.Value) (*resource.CreateResponse, map[string]any) {
+	t.Helper()
+
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "POST /audit_logs/events" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &AuditLogEventResource{client: c}
+
+	targetType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":   tftypes.String,
+		"type": tftypes.String,
+		"name": tftypes.String,
+	}}
+	plan := testResourceState(t, r, map[string]tftypes.Value{
+		"id":               tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"organization_id":  tftypes.NewValue(tftypes.String, "org_123"),
+		"action":           tftypes.NewValue(tftypes.String, "terraform.apply.completed"),
+		"occurred_at":      occurredAt,
+		"actor_id":         tftypes.NewValue(tftypes.String, "ci"),
+		"actor_type":       tftypes.NewValue(tftypes.String, "service"),
+		"context_location": tftypes.NewValue(tftypes.String, "10.0.0.1"),
+		"targets": tftypes.NewValue(tftypes.List{ElementType: targetType}, []tftypes.Value{
+			tftypes.NewValue(targetType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "workspace_prod"),
+				"type": tftypes.NewValue(tftypes.String, "workspace"),
+				"name": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+		"metadata": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"commit": tftypes.NewValue(tftypes.String, "abc123"),
+		}),
+	})
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+	}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+	return resp, body
+}
+
+func TestAuditLogEventResourceCreatePublishesEvent(t *testing.T) {
+	resp, body := createAuditLogEventForTest(t, tftypes.NewValue(tftypes.String, "2026-01-15T12:00:00Z"))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	event, ok := body["event"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected event in request body, got %#v", body)
+	}
+	if event["occurred_at"] != "2026-01-15T12:00:00Z" {
+		t.Fatalf("unexpected occurred_at: %#v", event["occurred_at"])
+	}
+	if metadata, _ := event["metadata"].(map[string]any); metadata["commit"] != "abc123" {
+		t.Fatalf("unexpected metadata: %#v", event["metadata"])
+	}
+
+	var state AuditLogEventResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.ID.ValueString() != "org_123/terraform.apply.completed/2026-01-15T12:00:00Z" {
+		t.Fatalf("unexpected ID: %s", state.ID.ValueString())
+	}
+}
+
+func TestAuditLogEventResourceCreateDefaultsOccurredAt(t *testing.T) {
+	resp, _ := createAuditLogEventForTest(t, tftypes.NewValue(tftypes.String, tftypes.UnknownValue))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state AuditLogEventResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.OccurredAt.IsNull() || state.OccurredAt.IsUnknown() {
+		t.Fatal("expected occurred_at to be set")
+	}
+}
+
+func TestAuditLogEventResourceCreateRejectsInvalidOccurredAt(t *testing.T) {
+	resp, body := createAuditLogEventForTest(t, tftypes.NewValue(tftypes.String, "yesterday"))
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a non-RFC3339 occurred_at")
+	}
+	if body != nil {
+		t.Fatalf("expected no request, got %#v", body)
+	}
+}
@@ -20,6 +20,7 @@ import (
 var _ resource.Resource = &AuthorizationResourceResource{}
 var _ resource.ResourceWithConfigValidators = &AuthorizationResourceResource{}
 var _ resource.ResourceWithImportState = &AuthorizationResourceResource{}
+var _ resource.ResourceWithUpgradeState = &AuthorizationResourceResource{}
 
 func NewAuthorizationResourceResource() resource.Resource {
 	return &AuthorizationResourceResource{}
@@ -50,6 +51,7 @@ func (r *AuthorizationResourceResource) Metadata(ctx context.Context, req resour
 
 func (r *AuthorizationResourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     authorizationResourceSchemaVersion,
 		Description: "Manages a WorkOS authorization resource.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -157,6 +159,8 @@ func (r *AuthorizationResourceResource) Configure(ctx context.Context, req resou
 }
 
 func (r *AuthorizationResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan AuthorizationResourceResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -185,6 +189,8 @@ func (r *AuthorizationResourceResource) Create(ctx context.Context, req resource
 }
 
 func (r *AuthorizationResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state AuthorizationResourceResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -193,7 +199,7 @@ func (r *AuthorizationResourceResource) Read(ctx context.Context, req resource.R
 
 	resource, err := r.client.GetAuthorizationResource(ctx, state.ID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -206,6 +212,8 @@ func (r *AuthorizationResourceResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *AuthorizationResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan AuthorizationResourceResourceModel
 	var state AuthorizationResourceResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -231,6 +239,8 @@ func (r *AuthorizationResourceResource) Update(ctx context.Context, req resource
 }
 
 func (r *AuthorizationResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state AuthorizationResourceResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -288,3 +298,7 @@ func authorizationResourceToState(state *AuthorizationResourceResourceModel, res
 	state.CreatedAt = types.StringValue(resource.CreatedAt.Format(time.RFC3339))
 	state.UpdatedAt = types.StringValue(resource.UpdatedAt.Format(time.RFC3339))
 }
+
+func (r *AuthorizationResourceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
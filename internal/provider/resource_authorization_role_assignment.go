@@ -19,6 +19,7 @@ import (
 var _ resource.Resource = &AuthorizationRoleAssignmentResource{}
 var _ resource.ResourceWithConfigValidators = &AuthorizationRoleAssignmentResource{}
 var _ resource.ResourceWithImportState = &AuthorizationRoleAssignmentResource{}
+var _ resource.ResourceWithUpgradeState = &AuthorizationRoleAssignmentResource{}
 
 func NewAuthorizationRoleAssignmentResource() resource.Resource {
 	return &AuthorizationRoleAssignmentResource{}
@@ -45,6 +46,7 @@ func (r *AuthorizationRoleAssignmentResource) Metadata(ctx context.Context, req
 
 func (r *AuthorizationRoleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     authorizationRoleAssignmentSchemaVersion,
 		Description: "Assigns a WorkOS authorization role to an organization membership on a resource.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -135,6 +137,8 @@ func (r *AuthorizationRoleAssignmentResource) Configure(ctx context.Context, req
 }
 
 func (r *AuthorizationRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan AuthorizationRoleAssignmentResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -162,6 +166,8 @@ func (r *AuthorizationRoleAssignmentResource) Create(ctx context.Context, req re
 }
 
 func (r *AuthorizationRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state AuthorizationRoleAssignmentResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -170,7 +176,7 @@ func (r *AuthorizationRoleAssignmentResource) Read(ctx context.Context, req reso
 
 	assignment, err := r.findAuthorizationRoleAssignment(ctx, state.OrganizationMembershipID.ValueString(), state.ID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -192,6 +198,8 @@ func (r *AuthorizationRoleAssignmentResource) Update(ctx context.Context, req re
 }
 
 func (r *AuthorizationRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state AuthorizationRoleAssignmentResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -245,9 +253,18 @@ func authorizationRoleAssignmentToState(state *AuthorizationRoleAssignmentResour
 	}
 	if assignment.Resource != nil {
 		state.ResourceID = types.StringValue(assignment.Resource.ID)
-		state.ResourceExternalID = types.StringValue(assignment.Resource.ExternalID)
-		state.ResourceTypeSlug = types.StringValue(assignment.Resource.ResourceTypeSlug)
+		// After an import the resource is identified by ID only, so that
+		// generated configuration satisfies the resource_id/resource_external_id
+		// ExactlyOneOf validator.
+		if !state.ResourceExternalID.IsNull() || !state.ResourceTypeSlug.IsNull() {
+			state.ResourceExternalID = types.StringValue(assignment.Resource.ExternalID)
+			state.ResourceTypeSlug = types.StringValue(assignment.Resource.ResourceTypeSlug)
+		}
 	}
 	state.CreatedAt = types.StringValue(assignment.CreatedAt.Format(time.RFC3339))
 	state.UpdatedAt = types.StringValue(assignment.UpdatedAt.Format(time.RFC3339))
 }
+
+func (r *AuthorizationRoleAssignmentResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
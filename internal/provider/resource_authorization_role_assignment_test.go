@@ -0,0 +1,61 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func testUserRoleAssignment() *client.UserRoleAssignment {
+	return &client.UserRoleAssignment{
+		ID:                       "role_assignment_123",
+		OrganizationMembershipID: "om_123",
+		Role:                     &client.UserRoleAssignmentRole{Slug: "project-viewer"},
+		Resource: &client.UserRoleAssignmentResource{
+			ID:               "authz_resource_123",
+			ExternalID:       "project-456",
+			ResourceTypeSlug: "project",
+		},
+	}
+}
+
+func TestAuthorizationRoleAssignmentToStateAfterImport(t *testing.T) {
+	state := &AuthorizationRoleAssignmentResourceModel{
+		ID:                       types.StringValue("role_assignment_123"),
+		OrganizationMembershipID: types.StringValue("om_123"),
+		RoleSlug:                 types.StringNull(),
+		ResourceID:               types.StringNull(),
+		ResourceExternalID:       types.StringNull(),
+		ResourceTypeSlug:         types.StringNull(),
+	}
+
+	authorizationRoleAssignmentToState(state, testUserRoleAssignment())
+
+	if state.RoleSlug.ValueString() != "project-viewer" || state.ResourceID.ValueString() != "authz_resource_123" {
+		t.Fatalf("unexpected state: %#v", state)
+	}
+	if !state.ResourceExternalID.IsNull() || !state.ResourceTypeSlug.IsNull() {
+		t.Fatalf("expected external ID attributes to stay null after import, got %s / %s", state.ResourceExternalID, state.ResourceTypeSlug)
+	}
+}
+
+func TestAuthorizationRoleAssignmentToStateKeepsExternalID(t *testing.T) {
+	state := &AuthorizationRoleAssignmentResourceModel{
+		ResourceID:         types.StringUnknown(),
+		ResourceExternalID: types.StringValue("project-456"),
+		ResourceTypeSlug:   types.StringValue("project"),
+	}
+
+	authorizationRoleAssignmentToState(state, testUserRoleAssignment())
+
+	if state.ResourceID.ValueString() != "authz_resource_123" {
+		t.Fatalf("unexpected resource_id: %s", state.ResourceID)
+	}
+	if state.ResourceExternalID.ValueString() != "project-456" || state.ResourceTypeSlug.ValueString() != "project" {
+		t.Fatalf("unexpected external ID attributes: %s / %s", state.ResourceExternalID, state.ResourceTypeSlug)
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 var _ resource.Resource = &ConnectApplicationResource{}
 var _ resource.ResourceWithImportState = &ConnectApplicationResource{}
+var _ resource.ResourceWithUpgradeState = &ConnectApplicationResource{}
 
 func NewConnectApplicationResource() resource.Resource {
 	return &ConnectApplicationResource{}
@@ -56,6 +57,7 @@ func (r *ConnectApplicationResource) Metadata(ctx context.Context, req resource.
 
 func (r *ConnectApplicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     connectApplicationSchemaVersion,
 		Description: "Manages a WorkOS Connect application.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -176,6 +178,8 @@ func (r *ConnectApplicationResource) Configure(ctx context.Context, req resource
 }
 
 func (r *ConnectApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan ConnectApplicationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -229,6 +233,8 @@ func (r *ConnectApplicationResource) Create(ctx context.Context, req resource.Cr
 }
 
 func (r *ConnectApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state ConnectApplicationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -237,7 +243,7 @@ func (r *ConnectApplicationResource) Read(ctx context.Context, req resource.Read
 
 	app, err := r.client.GetConnectApplication(ctx, state.ID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -253,6 +259,8 @@ func (r *ConnectApplicationResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *ConnectApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan ConnectApplicationResourceModel
 	var state ConnectApplicationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -293,6 +301,8 @@ func (r *ConnectApplicationResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *ConnectApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state ConnectApplicationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -408,3 +418,7 @@ func optionalBool(value *bool) types.Bool {
 	}
 	return types.BoolValue(*value)
 }
+
+func (r *ConnectApplicationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
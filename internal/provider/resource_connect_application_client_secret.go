@@ -0,0 +1,359 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+var _ resource.Resource = &ConnectApplicationClientSecretResource{}
+var _ resource.ResourceWithModifyPlan = &ConnectApplicationClientSecretResource{}
+var _ resource.ResourceWithUpgradeState = &ConnectApplicationClientSecretResource{}
+
+func NewConnectApplicationClientSecretResource() resource.Resource {
+	return &ConnectApplicationClientSecretResource{}
+}
+
+// ConnectApplicationClientSecretResource manages the client secret of a
+// Connect application. Changing rotation_triggers creates a new secret and,
+// unless keep_previous_secret is false, keeps the one it replaces valid until
+// the next rotation so clients can be moved over without downtime.
+type ConnectApplicationClientSecretResource struct {
+	client *client.Client
+}
+
+type ConnectApplicationClientSecretResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ApplicationID      types.String `tfsdk:"application_id"`
+	RotationTriggers   types.Map    `tfsdk:"rotation_triggers"`
+	KeepPreviousSecret types.Bool   `tfsdk:"keep_previous_secret"`
+	Secret             types.String `tfsdk:"secret"`
+	SecretHint         types.String `tfsdk:"secret_hint"`
+	PreviousSecretID   types.String `tfsdk:"previous_secret_id"`
+	PreviousSecret     types.String `tfsdk:"previous_secret"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+}
+
+func (r *ConnectApplicationClientSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_application_client_secret"
+}
+
+func (r *ConnectApplicationClientSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: connectApplicationClientSecretSchemaVersion,
+		Description: "Manages a client secret of a WorkOS Connect application. Changing rotation_triggers rotates the secret; " +
+			"the previous secret stays valid until the next rotation unless keep_previous_secret is false.",
+		MarkdownDescription: `
+Manages a client secret of a WorkOS Connect application.
+
+Changing ` + "`rotation_triggers`" + ` rotates the secret: a new secret is created and
+exposed as ` + "`secret`" + `, and the secret it replaces moves to ` + "`previous_secret`" + `,
+where it stays valid until the next rotation. Deploy the new secret to every
+client before rotating again. Set ` + "`keep_previous_secret = false`" + ` to delete
+the old secret as soon as the new one is created.
+
+The secret is only returned by WorkOS when it is created, so it is stored in
+state as a sensitive value and cannot be imported.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the current client secret.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				Description: "The ID of the Connect application. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Description: "Arbitrary values that rotate the secret when they change, for example a date.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"keep_previous_secret": schema.BoolAttribute{
+				Description: "Keep the secret replaced by a rotation valid until the next rotation. Defaults to true.",
+				MarkdownDescription: "Keep the secret replaced by a rotation valid until the next rotation. " +
+					"When `false`, the old secret is deleted as soon as the new one is created. Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"secret": schema.StringAttribute{
+				Description: "The current client secret. Only returned when the secret is created.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret_hint": schema.StringAttribute{
+				Description: "The last characters of the current client secret, for identifying it in the Dashboard.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_secret_id": schema.StringAttribute{
+				Description: "The ID of the secret replaced by the last rotation, while it is still valid.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_secret": schema.StringAttribute{
+				Description: "The secret replaced by the last rotation, while it is still valid.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the current secret was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ConnectApplicationClientSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *ConnectApplicationClientSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var plan ConnectApplicationClientSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.CreateConnectApplicationSecret(ctx, plan.ApplicationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Connect Application Client Secret",
+			"Could not create client secret for Connect application "+plan.ApplicationID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	connectApplicationSecretToState(&plan, secret)
+	plan.PreviousSecretID = types.StringNull()
+	plan.PreviousSecret = types.StringNull()
+
+	tflog.Info(ctx, "Created Connect application client secret", map[string]any{
+		"id":             secret.ID,
+		"application_id": plan.ApplicationID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ConnectApplicationClientSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var state ConnectApplicationClientSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, err := r.client.ListConnectApplicationSecrets(ctx, state.ApplicationID.ValueString())
+	if err != nil {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Connect Application Client Secret",
+			"Could not list client secrets for Connect application "+state.ApplicationID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	current, previous := false, false
+	for _, secret := range secrets.Data {
+		switch secret.ID {
+		case state.ID.ValueString():
+			current = true
+			state.SecretHint = types.StringValue(secret.SecretHint)
+		case state.PreviousSecretID.ValueString():
+			previous = true
+		}
+	}
+
+	// A secret deleted outside Terraform cannot be recovered, so a new one is
+	// created on the next apply.
+	if !current {
+		tflog.Warn(ctx, "Connect application client secret not found, removing from state", map[string]any{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if !previous {
+		state.PreviousSecretID = types.StringNull()
+		state.PreviousSecret = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ConnectApplicationClientSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var plan, state ConnectApplicationClientSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotationTriggers.Equal(state.RotationTriggers) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	applicationID := plan.ApplicationID.ValueString()
+	secret, err := r.client.CreateConnectApplicationSecret(ctx, applicationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Rotating Connect Application Client Secret",
+			"Could not create client secret for Connect application "+applicationID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only one previous secret is kept, so the one from the rotation before
+	// this is retired now.
+	retired := []string{state.PreviousSecretID.ValueString()}
+	plan.PreviousSecretID = state.ID
+	plan.PreviousSecret = state.Secret
+	if !plan.KeepPreviousSecret.ValueBool() {
+		retired = append(retired, state.ID.ValueString())
+		plan.PreviousSecretID = types.StringNull()
+		plan.PreviousSecret = types.StringNull()
+	}
+	connectApplicationSecretToState(&plan, secret)
+
+	// The new secret is saved before the old ones are deleted so it is not
+	// lost if a deletion fails.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, id := range retired {
+		if id == "" {
+			continue
+		}
+		if err := r.client.DeleteConnectApplicationSecret(ctx, id); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Rotating Connect Application Client Secret",
+				"Created client secret "+secret.ID+" but could not delete the old secret "+id+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Rotated Connect application client secret", map[string]any{
+		"id":             secret.ID,
+		"application_id": applicationID,
+	})
+}
+
+func (r *ConnectApplicationClientSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var state ConnectApplicationClientSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, id := range []string{state.PreviousSecretID.ValueString(), state.ID.ValueString()} {
+		if id == "" {
+			continue
+		}
+		err := r.client.DeleteConnectApplicationSecret(ctx, id)
+		if err != nil {
+			if client.IsNotFound(err) {
+				tflog.Info(ctx, "Connect application client secret already deleted", map[string]any{"id": id})
+				continue
+			}
+			resp.Diagnostics.AddError(
+				"Error Deleting Connect Application Client Secret",
+				"Could not delete client secret "+id+": "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *ConnectApplicationClientSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ConnectApplicationClientSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A rotation replaces every computed attribute except the application.
+	if !plan.RotationTriggers.Equal(state.RotationTriggers) {
+		for _, attribute := range []string{"id", "secret", "secret_hint", "previous_secret_id", "previous_secret", "created_at"} {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.StringUnknown())...)
+		}
+	}
+}
+
+// connectApplicationSecretToState copies a newly created secret into the
+// current secret attributes.
+func connectApplicationSecretToState(state *ConnectApplicationClientSecretResourceModel, secret *client.ConnectApplicationSecret) {
+	state.ID = types.StringValue(secret.ID)
+	state.Secret = types.StringValue(secret.Secret)
+	state.SecretHint = types.StringValue(secret.SecretHint)
+	state.CreatedAt = types.StringValue(secret.CreatedAt.Format(time.RFC3339))
+}
+
+func (r *ConnectApplicationClientSecretResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
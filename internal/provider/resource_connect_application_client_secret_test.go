@@ -0,0 +1,167 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func connectApplicationClientSecretValues(trigger string, keepPrevious bool) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, "secret_2"),
+		"application_id": tftypes.NewValue(tftypes.String, "conn_app_123"),
+		"rotation_triggers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"quarter": tftypes.NewValue(tftypes.String, trigger),
+		}),
+		"keep_previous_secret": tftypes.NewValue(tftypes.Bool, keepPrevious),
+		"secret":               tftypes.NewValue(tftypes.String, "sk_secret_2"),
+		"secret_hint":          tftypes.NewValue(tftypes.String, "et_2"),
+		"previous_secret_id":   tftypes.NewValue(tftypes.String, "secret_1"),
+		"previous_secret":      tftypes.NewValue(tftypes.String, "sk_secret_1"),
+		"created_at":           tftypes.NewValue(tftypes.String, "2026-07-01T12:00:00Z"),
+	}
+}
+
+func newConnectApplicationClientSecretResourceForTest(t *testing.T, handler http.HandlerFunc) *ConnectApplicationClientSecretResource {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return &ConnectApplicationClientSecretResource{client: c}
+}
+
+func TestConnectApplicationClientSecretResourceRotate(t *testing.T) {
+	testCases := map[string]struct {
+		keepPrevious     bool
+		expectRequests   []string
+		expectPreviousID string
+	}{
+		"keep previous": {
+			keepPrevious: true,
+			expectRequests: []string{
+				"POST /connect/applications/conn_app_123/client_secrets",
+				"DELETE /connect/client_secrets/secret_1",
+			},
+			expectPreviousID: "secret_2",
+		},
+		"discard previous": {
+			expectRequests: []string{
+				"POST /connect/applications/conn_app_123/client_secrets",
+				"DELETE /connect/client_secrets/secret_1",
+				"DELETE /connect/client_secrets/secret_2",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			r := newConnectApplicationClientSecretResourceForTest(t, func(w http.ResponseWriter, req *http.Request) {
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				if req.Method == http.MethodDelete {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":"secret_3","object":"connect_application_secret","secret":"sk_secret_3","secret_hint":"et_3","created_at":"2026-10-01T12:00:00.000Z","updated_at":"2026-10-01T12:00:00.000Z"}`))
+			})
+
+			state := testResourceState(t, r, connectApplicationClientSecretValues("2026-Q3", tc.keepPrevious))
+			plan := testResourceState(t, r, connectApplicationClientSecretValues("2026-Q4", tc.keepPrevious))
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(context.Background(), resource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				State: state,
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if fmt.Sprint(requests) != fmt.Sprint(tc.expectRequests) {
+				t.Fatalf("expected requests %v, got %v", tc.expectRequests, requests)
+			}
+
+			var result ConnectApplicationClientSecretResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+			if result.ID.ValueString() != "secret_3" || result.Secret.ValueString() != "sk_secret_3" {
+				t.Fatalf("expected the new secret, got %#v", result)
+			}
+			if result.PreviousSecretID.ValueString() != tc.expectPreviousID {
+				t.Fatalf("expected previous_secret_id %q, got %s", tc.expectPreviousID, result.PreviousSecretID)
+			}
+			if tc.keepPrevious && result.PreviousSecret.ValueString() != "sk_secret_2" {
+				t.Fatalf("expected the replaced secret to be kept, got %s", result.PreviousSecret)
+			}
+		})
+	}
+}
+
+func TestConnectApplicationClientSecretResourceRead(t *testing.T) {
+	testCases := map[string]struct {
+		response       string
+		expectRemoved  bool
+		expectPrevious bool
+	}{
+		"both secrets": {
+			response:       `{"data":[{"id":"secret_1","secret_hint":"et_1"},{"id":"secret_2","secret_hint":"et_2"}]}`,
+			expectPrevious: true,
+		},
+		"previous deleted": {
+			response: `{"data":[{"id":"secret_2","secret_hint":"et_2"}]}`,
+		},
+		"current deleted": {
+			response:      `{"data":[{"id":"secret_1","secret_hint":"et_1"}]}`,
+			expectRemoved: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := newConnectApplicationClientSecretResourceForTest(t, func(w http.ResponseWriter, req *http.Request) {
+				if req.Method != http.MethodGet || req.URL.Path != "/connect/applications/conn_app_123/client_secrets" {
+					t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.response))
+			})
+
+			state := testResourceState(t, r, connectApplicationClientSecretValues("2026-Q4", true))
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if tc.expectRemoved {
+				if !resp.State.Raw.IsNull() {
+					t.Fatal("expected the secret to be removed from state")
+				}
+				return
+			}
+
+			var result ConnectApplicationClientSecretResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+			if result.Secret.ValueString() != "sk_secret_2" {
+				t.Fatalf("expected the secret to be kept, got %s", result.Secret)
+			}
+			if result.PreviousSecret.IsNull() == tc.expectPrevious {
+				t.Fatalf("expected previous secret kept=%t, got %s", tc.expectPrevious, result.PreviousSecret)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 var _ resource.Resource = &EnvironmentRoleResource{}
 var _ resource.ResourceWithImportState = &EnvironmentRoleResource{}
 var _ resource.ResourceWithModifyPlan = &EnvironmentRoleResource{}
+var _ resource.ResourceWithUpgradeState = &EnvironmentRoleResource{}
 
 func NewEnvironmentRoleResource() resource.Resource {
 	return &EnvironmentRoleResource{}
@@ -50,6 +51,7 @@ func (r *EnvironmentRoleResource) Metadata(ctx context.Context, req resource.Met
 
 func (r *EnvironmentRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     environmentRoleSchemaVersion,
 		Description: "Manages a WorkOS environment-level role.",
 		MarkdownDescription: `
 Manages a WorkOS environment-level role.
@@ -228,6 +230,8 @@ func (r *EnvironmentRoleResource) ModifyPlan(ctx context.Context, req resource.M
 }
 
 func (r *EnvironmentRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan EnvironmentRoleResourceModel
 	var config EnvironmentRoleResourceModel
 
@@ -293,6 +297,8 @@ func (r *EnvironmentRoleResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *EnvironmentRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state EnvironmentRoleResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -306,7 +312,7 @@ func (r *EnvironmentRoleResource) Read(ctx context.Context, req resource.ReadReq
 
 	role, err := r.client.GetEnvironmentRole(ctx, state.Slug.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			tflog.Info(ctx, "Environment role not found, removing from state", map[string]any{
 				"slug": state.Slug.ValueString(),
 			})
@@ -330,6 +336,8 @@ func (r *EnvironmentRoleResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *EnvironmentRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan EnvironmentRoleResourceModel
 	var state EnvironmentRoleResourceModel
 	var config EnvironmentRoleResourceModel
@@ -429,3 +437,7 @@ func (r *EnvironmentRoleResource) ImportState(ctx context.Context, req resource.
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), req.ID)...)
 }
+
+func (r *EnvironmentRoleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
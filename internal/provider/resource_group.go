@@ -17,6 +17,8 @@ import (
 
 var _ resource.Resource = &GroupResource{}
 var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithModifyPlan = &GroupResource{}
+var _ resource.ResourceWithUpgradeState = &GroupResource{}
 
 func NewGroupResource() resource.Resource {
 	return &GroupResource{}
@@ -41,6 +43,7 @@ func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataReque
 
 func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     groupSchemaVersion,
 		Description: "Manages a WorkOS group within an organization.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -96,6 +99,8 @@ func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureReq
 }
 
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan GroupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -118,6 +123,8 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state GroupResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -126,7 +133,7 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	group, err := r.client.GetGroup(ctx, state.OrganizationID.ValueString(), state.ID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -139,6 +146,8 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan GroupResourceModel
 	var state GroupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -163,6 +172,8 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state GroupResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -179,6 +190,10 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *GroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateReferences(ctx, r.client, req, resp, "organization_id")
+}
+
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := splitCompositeID(req.ID, 2)
 	if len(parts) != 2 {
@@ -198,3 +213,7 @@ func groupToState(state *GroupResourceModel, group *client.Group) {
 	state.CreatedAt = types.StringValue(group.CreatedAt.Format(time.RFC3339))
 	state.UpdatedAt = types.StringValue(group.UpdatedAt.Format(time.RFC3339))
 }
+
+func (r *GroupResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
@@ -17,6 +17,8 @@ import (
 
 var _ resource.Resource = &GroupMembershipResource{}
 var _ resource.ResourceWithImportState = &GroupMembershipResource{}
+var _ resource.ResourceWithModifyPlan = &GroupMembershipResource{}
+var _ resource.ResourceWithUpgradeState = &GroupMembershipResource{}
 
 func NewGroupMembershipResource() resource.Resource {
 	return &GroupMembershipResource{}
@@ -43,6 +45,7 @@ func (r *GroupMembershipResource) Metadata(ctx context.Context, req resource.Met
 
 func (r *GroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     groupMembershipSchemaVersion,
 		Description: "Adds a WorkOS organization membership to a group.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -111,6 +114,8 @@ func (r *GroupMembershipResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan GroupMembershipResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -136,6 +141,8 @@ func (r *GroupMembershipResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state GroupMembershipResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -144,7 +151,7 @@ func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadReq
 
 	membership, err := r.findGroupMembership(ctx, state.OrganizationID.ValueString(), state.GroupID.ValueString(), state.OrganizationMembershipID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -167,6 +174,8 @@ func (r *GroupMembershipResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state GroupMembershipResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -183,6 +192,10 @@ func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+func (r *GroupMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateReferences(ctx, r.client, req, resp, "organization_id", "user_id")
+}
+
 func (r *GroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := splitCompositeID(req.ID, 3)
 	if len(parts) != 3 {
@@ -221,3 +234,7 @@ func groupMembershipToState(state *GroupMembershipResourceModel, membership *cli
 	state.CreatedAt = types.StringValue(membership.CreatedAt.Format(time.RFC3339))
 	state.UpdatedAt = types.StringValue(membership.UpdatedAt.Format(time.RFC3339))
 }
+
+func (r *GroupMembershipResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
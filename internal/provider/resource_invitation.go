@@ -0,0 +1,420 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InvitationResource{}
+var _ resource.ResourceWithImportState = &InvitationResource{}
+var _ resource.ResourceWithModifyPlan = &InvitationResource{}
+var _ resource.ResourceWithUpgradeState = &InvitationResource{}
+
+func NewInvitationResource() resource.Resource {
+	return &InvitationResource{}
+}
+
+// InvitationResource defines the resource implementation.
+type InvitationResource struct {
+	client *client.Client
+}
+
+// InvitationResourceModel describes the resource data model.
+type InvitationResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Email            types.String `tfsdk:"email"`
+	OrganizationID   types.String `tfsdk:"organization_id"`
+	RoleSlug         types.String `tfsdk:"role_slug"`
+	InviterUserID    types.String `tfsdk:"inviter_user_id"`
+	ExpiresInDays    types.Int64  `tfsdk:"expires_in_days"`
+	RecreateOnExpiry types.Bool   `tfsdk:"recreate_on_expiry"`
+	State            types.String `tfsdk:"state"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	AcceptedAt       types.String `tfsdk:"accepted_at"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+}
+
+func (r *InvitationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invitation"
+}
+
+func (r *InvitationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     invitationSchemaVersion,
+		Description: "Manages a WorkOS AuthKit invitation.",
+		MarkdownDescription: `
+Manages a WorkOS AuthKit invitation.
+
+Invitations email a user a link to sign up to the environment or to join an
+organization. Changing any argument other than ` + "`recreate_on_expiry`" + ` sends a new invitation.
+
+The invitation is kept in state for its whole lifecycle:
+
+- **Accepted** invitations are reported with ` + "`state = \"accepted\"`" + ` and ` + "`accepted_at`" + `; this is
+  not drift and destroying the resource leaves the user's account and membership in place.
+- **Expired** invitations are reported with ` + "`state = \"expired\"`" + `. With ` + "`recreate_on_expiry = true`" + `,
+  the next apply replaces the invitation with a new one.
+- **Revoked** invitations are treated as deleted outside of Terraform, so the next
+  apply sends a new invitation.
+
+Destroying a pending invitation revokes it.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "workos_invitation" "jane" {
+  email              = "jane@example.com"
+  organization_id    = workos_organization.acme.id
+  role_slug          = "admin"
+  expires_in_days    = 14
+  recreate_on_expiry = true
+}
+` + "```" + `
+
+## Import
+
+Invitations can be imported using the invitation ID. ` + "`role_slug`" + ` and
+` + "`expires_in_days`" + ` are not returned by WorkOS and are left unset:
+
+` + "```shell" + `
+terraform import workos_invitation.jane invitation_01HXYZ...
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The unique identifier of the invitation.",
+				MarkdownDescription: "The unique identifier of the invitation (e.g., `invitation_01HXYZ...`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description:         "The email address to invite.",
+				MarkdownDescription: "The email address to invite.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description:         "The ID of the organization to invite the user to.",
+				MarkdownDescription: "The ID of the organization to invite the user to. When unset, the user is invited to the environment.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_slug": schema.StringAttribute{
+				Description:         "The slug of the role the user is given in the organization when accepting the invitation.",
+				MarkdownDescription: "The slug of the role the user is given in the organization when accepting the invitation (e.g., `admin`). Requires `organization_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("organization_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"inviter_user_id": schema.StringAttribute{
+				Description:         "The ID of the user sending the invitation.",
+				MarkdownDescription: "The ID of the user the invitation is sent on behalf of.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_in_days": schema.Int64Attribute{
+				Description:         "The number of days the invitation is valid for, between 1 and 30.",
+				MarkdownDescription: "The number of days the invitation is valid for, between 1 and 30. Defaults to the WorkOS default of 7 days.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 30),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"recreate_on_expiry": schema.BoolAttribute{
+				Description:         "Whether an expired invitation is replaced with a new one on the next apply.",
+				MarkdownDescription: "Whether an expired invitation is replaced with a new one on the next apply. Defaults to `false`, which keeps the expired invitation in state.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"state": schema.StringAttribute{
+				Description:         "The state of the invitation.",
+				MarkdownDescription: "The state of the invitation (`pending`, `accepted`, `expired`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description:         "The timestamp when the invitation expires.",
+				MarkdownDescription: "The timestamp when the invitation expires (RFC3339 format).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"accepted_at": schema.StringAttribute{
+				Description:         "The timestamp when the invitation was accepted.",
+				MarkdownDescription: "The timestamp when the invitation was accepted (RFC3339 format), if it has been.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description:         "The timestamp when the invitation was sent.",
+				MarkdownDescription: "The timestamp when the invitation was sent (RFC3339 format).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description:         "The timestamp when the invitation was last updated.",
+				MarkdownDescription: "The timestamp when the invitation was last updated (RFC3339 format).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InvitationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *InvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var plan InvitationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Sending invitation", map[string]any{
+		"email":           plan.Email.ValueString(),
+		"organization_id": plan.OrganizationID.ValueString(),
+	})
+
+	invitation, err := r.client.SendInvitation(ctx, &client.InvitationCreateRequest{
+		Email:          plan.Email.ValueString(),
+		OrganizationID: plan.OrganizationID.ValueString(),
+		RoleSlug:       plan.RoleSlug.ValueString(),
+		ExpiresInDays:  plan.ExpiresInDays.ValueInt64(),
+		InviterUserID:  plan.InviterUserID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Sending Invitation",
+			"Could not send invitation to "+plan.Email.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapInvitationToState(&plan, invitation)
+
+	tflog.Info(ctx, "Sent invitation", map[string]any{
+		"id": invitation.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *InvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var state InvitationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading invitation", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	invitation, err := r.client.GetInvitation(ctx, state.ID.ValueString())
+	if err != nil {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
+			tflog.Info(ctx, "Invitation not found, removing from state", map[string]any{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Invitation",
+			"Could not read invitation ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// A revoked invitation can never be accepted; treat it like one deleted
+	// outside of Terraform so that a new one is sent.
+	if invitation.State == "revoked" {
+		tflog.Info(ctx, "Invitation was revoked, removing from state", map[string]any{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapInvitationToState(&state, invitation)
+	if state.RecreateOnExpiry.IsNull() {
+		state.RecreateOnExpiry = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *InvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan InvitationResourceModel
+	var state InvitationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every argument but recreate_on_expiry forces a new invitation, so there
+	// is nothing to send to WorkOS.
+	state.RecreateOnExpiry = plan.RecreateOnExpiry
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *InvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var state InvitationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.State.ValueString() != "pending" {
+		tflog.Info(ctx, "Invitation is no longer pending, removing from state", map[string]any{
+			"id":    state.ID.ValueString(),
+			"state": state.State.ValueString(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "Revoking invitation", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	if _, err := r.client.RevokeInvitation(ctx, state.ID.ValueString()); err != nil {
+		if client.IsNotFound(err) {
+			tflog.Info(ctx, "Invitation already deleted", map[string]any{
+				"id": state.ID.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Revoking Invitation",
+			"Could not revoke invitation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Revoked invitation", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *InvitationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateReferences(ctx, r.client, req, resp, "organization_id")
+
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state types.String
+	var recreateOnExpiry types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("state"), &state)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("recreate_on_expiry"), &recreateOnExpiry)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Terraform only replaces a resource for an attribute that changes, so
+	// the expired state is planned to change along with the replacement.
+	if state.ValueString() == "expired" && recreateOnExpiry.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("state"), types.StringUnknown())...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("state"))
+	}
+}
+
+func (r *InvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing invitation", map[string]any{
+		"id": req.ID,
+	})
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapInvitationToState copies the attributes WorkOS returns for an
+// invitation. role_slug and expires_in_days are not returned and are kept.
+func mapInvitationToState(model *InvitationResourceModel, invitation *client.Invitation) {
+	model.ID = types.StringValue(invitation.ID)
+	model.Email = types.StringValue(invitation.Email)
+	model.OrganizationID = optionalString(&invitation.OrganizationID)
+	model.InviterUserID = optionalString(&invitation.InviterUserID)
+	model.State = types.StringValue(invitation.State)
+	model.ExpiresAt = types.StringValue(invitation.ExpiresAt.Format(time.RFC3339))
+	model.AcceptedAt = optionalTime(invitation.AcceptedAt)
+	model.CreatedAt = types.StringValue(invitation.CreatedAt.Format(time.RFC3339))
+	model.UpdatedAt = types.StringValue(invitation.UpdatedAt.Format(time.RFC3339))
+}
+
+func (r *InvitationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
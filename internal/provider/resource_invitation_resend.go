@@ -0,0 +1,233 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+var _ resource.Resource = &InvitationResendResource{}
+var _ resource.ResourceWithConfigValidators = &InvitationResendResource{}
+var _ resource.ResourceWithUpgradeState = &InvitationResendResource{}
+
+func NewInvitationResendResource() resource.Resource {
+	return &InvitationResendResource{}
+}
+
+// InvitationResendResource re-sends an AuthKit invitation email when it is
+// created. It has no remote object of its own: destroying it does nothing,
+// and changing triggers sends the invitation again.
+type InvitationResendResource struct {
+	client *client.Client
+}
+
+type InvitationResendResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	InvitationID   types.String `tfsdk:"invitation_id"`
+	Email          types.String `tfsdk:"email"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+	State          types.String `tfsdk:"state"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	ResentAt       types.String `tfsdk:"resent_at"`
+}
+
+func (r *InvitationResendResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invitation_resend"
+}
+
+func (r *InvitationResendResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     invitationResendSchemaVersion,
+		Description: "Re-sends a pending or expired AuthKit invitation email when created. Destroying the resource does not affect the invitation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the invitation that was re-sent.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invitation_id": schema.StringAttribute{
+				Description: "The ID of the invitation to re-send. Exactly one of invitation_id or email must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Re-send the most recent pending or expired invitation sent to this email address. Exactly one of invitation_id or email must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Only consider invitations to this organization when looking up the invitation by email.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that re-send the invitation when they change.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "The state of the invitation after it was re-sent.",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "The timestamp when the re-sent invitation expires.",
+				Computed:    true,
+			},
+			"resent_at": schema.StringAttribute{
+				Description: "The timestamp when the invitation was re-sent.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *InvitationResendResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("invitation_id"),
+			path.MatchRoot("email"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("invitation_id"),
+			path.MatchRoot("organization_id"),
+		),
+	}
+}
+
+func (r *InvitationResendResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *InvitationResendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var plan InvitationResendResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invitationID := plan.InvitationID.ValueString()
+	if plan.InvitationID.IsNull() {
+		id, err := r.findResendableInvitation(ctx, plan.Email.ValueString(), plan.OrganizationID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error Finding Invitation", "Could not find an invitation for "+plan.Email.ValueString()+": "+err.Error())
+			return
+		}
+		invitationID = id
+	}
+
+	invitation, err := r.client.ResendInvitation(ctx, invitationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Resending Invitation", "Could not resend invitation "+invitationID+": "+err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Resent invitation", map[string]any{"id": invitation.ID})
+
+	plan.ID = types.StringValue(invitation.ID)
+	plan.State = types.StringValue(invitation.State)
+	plan.ExpiresAt = types.StringValue(invitation.ExpiresAt.Format(time.RFC3339))
+	plan.ResentAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *InvitationResendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state InvitationResendResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *InvitationResendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan InvitationResendResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *InvitationResendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state InvitationResendResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removing invitation resend from state; the invitation is not affected", map[string]any{"id": state.ID.ValueString()})
+}
+
+// findResendableInvitation returns the ID of the most recently created
+// pending or expired invitation for an email address. Accepted and revoked
+// invitations cannot be re-sent.
+func (r *InvitationResendResource) findResendableInvitation(ctx context.Context, email, organizationID string) (string, error) {
+	invitations, err := r.client.ListInvitations(ctx, email, organizationID)
+	if err != nil {
+		return "", err
+	}
+
+	var latest *client.Invitation
+	for i := range invitations.Data {
+		invitation := &invitations.Data[i]
+		if invitation.State != "pending" && invitation.State != "expired" {
+			continue
+		}
+		if latest == nil || invitation.CreatedAt.After(latest.CreatedAt) {
+			latest = invitation
+		}
+	}
+	if latest == nil {
+		return "", fmt.Errorf("no pending or expired invitation found")
+	}
+
+	return latest.ID, nil
+}
+
+func (r *InvitationResendResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
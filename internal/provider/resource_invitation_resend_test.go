@@ -0,0 +1,102 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestInvitationResendResourceCreateByEmail(t *testing.T) {
+	var resent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /user_management/invitations":
+			if got := r.URL.Query().Get("email"); got != "admin@acme.com" {
+				t.Fatalf("expected email=admin@acme.com, got %q", got)
+			}
+			if got := r.URL.Query().Get("organization_id"); got != "org_123" {
+				t.Fatalf("expected organization_id=org_123, got %q", got)
+			}
+			_, _ = w.Write([]byte(`{"data":[
+  {"id":"invitation_old","state":"expired","created_at":"2026-01-01T12:00:00.000Z"},
+  {"id":"invitation_recent","state":"expired","created_at":"2026-02-01T12:00:00.000Z"},
+  {"id":"invitation_accepted","state":"accepted","created_at":"2026-03-01T12:00:00.000Z"}
+],"list_metadata":{}}`))
+		case "POST /user_management/invitations/invitation_recent/resend":
+			resent = append(resent, "invitation_recent")
+			_, _ = w.Write([]byte(`{"id":"invitation_recent","state":"pending","expires_at":"2026-10-23T12:00:00.000Z"}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &InvitationResendResource{client: c}
+
+	plan := testResourceState(t, r, map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"email":           tftypes.NewValue(tftypes.String, "admin@acme.com"),
+		"organization_id": tftypes.NewValue(tftypes.String, "org_123"),
+		"state":           tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"expires_at":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"resent_at":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: plan.Schema,
+			Raw:    tftypes.NewValue(plan.Raw.Type(), nil),
+		},
+	}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state InvitationResendResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if len(resent) != 1 {
+		t.Fatalf("expected the most recent expired invitation to be resent once, got %v", resent)
+	}
+	if state.ID.ValueString() != "invitation_recent" || state.State.ValueString() != "pending" {
+		t.Fatalf("unexpected state: %#v", state)
+	}
+	if state.ExpiresAt.ValueString() != "2026-10-23T12:00:00Z" {
+		t.Fatalf("unexpected expires_at: %s", state.ExpiresAt.ValueString())
+	}
+}
+
+func TestInvitationResendResourceFindRejectsAcceptedInvitations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"invitation_accepted","state":"accepted"},{"id":"invitation_revoked","state":"revoked"}],"list_metadata":{}}`))
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &InvitationResendResource{client: c}
+
+	if _, err := r.findResendableInvitation(context.Background(), "admin@acme.com", ""); err == nil {
+		t.Fatal("expected an error when no invitation can be resent")
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func invitationValues(state string, recreateOnExpiry bool) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, "invitation_123"),
+		"email":              tftypes.NewValue(tftypes.String, "jane@example.com"),
+		"organization_id":    tftypes.NewValue(tftypes.String, "org_123"),
+		"role_slug":          tftypes.NewValue(tftypes.String, "admin"),
+		"recreate_on_expiry": tftypes.NewValue(tftypes.Bool, recreateOnExpiry),
+		"state":              tftypes.NewValue(tftypes.String, state),
+		"expires_at":         tftypes.NewValue(tftypes.String, "2026-01-22T12:00:00Z"),
+		"created_at":         tftypes.NewValue(tftypes.String, "2026-01-15T12:00:00Z"),
+		"updated_at":         tftypes.NewValue(tftypes.String, "2026-01-15T12:00:00Z"),
+	}
+}
+
+func newInvitationResourceForTest(t *testing.T, handler http.HandlerFunc) *InvitationResource {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return &InvitationResource{client: c}
+}
+
+func TestInvitationResourceCreate(t *testing.T) {
+	var body map[string]any
+	r := newInvitationResourceForTest(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.Path != "/user_management/invitations" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"invitation_123","email":"jane@example.com","state":"pending","organization_id":"org_123","expires_at":"2026-01-29T12:00:00.000Z","created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"}`))
+	})
+
+	values := invitationValues("", false)
+	for _, name := range []string{"id", "state", "expires_at", "accepted_at", "created_at", "updated_at"} {
+		values[name] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	}
+	values["expires_in_days"] = tftypes.NewValue(tftypes.Number, 14)
+	plan := testResourceState(t, r, values)
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+	}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if body["email"] != "jane@example.com" || body["role_slug"] != "admin" || body["expires_in_days"] != float64(14) {
+		t.Fatalf("unexpected request body: %#v", body)
+	}
+
+	var state InvitationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.State.ValueString() != "pending" || !state.AcceptedAt.IsNull() {
+		t.Fatalf("unexpected state: %#v", state)
+	}
+	if state.RoleSlug.ValueString() != "admin" || state.ExpiresInDays.ValueInt64() != 14 {
+		t.Fatalf("expected role_slug and expires_in_days to be kept, got %#v", state)
+	}
+}
+
+func TestInvitationResourceRead(t *testing.T) {
+	testCases := map[string]struct {
+		response      string
+		expectRemoved bool
+		expectState   string
+	}{
+		"pending": {
+			response:    `{"id":"invitation_123","email":"jane@example.com","state":"pending","organization_id":"org_123","expires_at":"2026-01-22T12:00:00.000Z"}`,
+			expectState: "pending",
+		},
+		"accepted": {
+			response:    `{"id":"invitation_123","email":"jane@example.com","state":"accepted","organization_id":"org_123","accepted_at":"2026-01-16T12:00:00.000Z","expires_at":"2026-01-22T12:00:00.000Z"}`,
+			expectState: "accepted",
+		},
+		"expired": {
+			response:    `{"id":"invitation_123","email":"jane@example.com","state":"expired","organization_id":"org_123","expires_at":"2026-01-22T12:00:00.000Z"}`,
+			expectState: "expired",
+		},
+		"revoked": {
+			response:      `{"id":"invitation_123","email":"jane@example.com","state":"revoked","organization_id":"org_123","revoked_at":"2026-01-16T12:00:00.000Z"}`,
+			expectRemoved: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := newInvitationResourceForTest(t, func(w http.ResponseWriter, req *http.Request) {
+				if req.Method != http.MethodGet || req.URL.Path != "/user_management/invitations/invitation_123" {
+					t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.response))
+			})
+
+			state := testResourceState(t, r, invitationValues("pending", false))
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if tc.expectRemoved {
+				if !resp.State.Raw.IsNull() {
+					t.Fatal("expected the invitation to be removed from state")
+				}
+				return
+			}
+
+			var result InvitationResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+			if result.State.ValueString() != tc.expectState {
+				t.Fatalf("expected state %q, got %q", tc.expectState, result.State.ValueString())
+			}
+			if tc.expectState == "accepted" && result.AcceptedAt.ValueString() != "2026-01-16T12:00:00Z" {
+				t.Fatalf("unexpected accepted_at: %s", result.AcceptedAt)
+			}
+			if result.RoleSlug.ValueString() != "admin" {
+				t.Fatalf("expected role_slug to be kept, got %s", result.RoleSlug)
+			}
+		})
+	}
+}
+
+func TestInvitationResourceModifyPlan(t *testing.T) {
+	testCases := map[string]struct {
+		state            string
+		recreateOnExpiry bool
+		expectReplace    bool
+	}{
+		"expired with recreate":    {state: "expired", recreateOnExpiry: true, expectReplace: true},
+		"expired without recreate": {state: "expired", recreateOnExpiry: false},
+		"accepted with recreate":   {state: "accepted", recreateOnExpiry: true},
+		"pending with recreate":    {state: "pending", recreateOnExpiry: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &InvitationResource{}
+			state := testResourceState(t, r, invitationValues(tc.state, tc.recreateOnExpiry))
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+				Plan:   plan,
+				State:  state,
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			replaced := len(resp.RequiresReplace) == 1 && resp.RequiresReplace[0].Equal(path.Root("state"))
+			if replaced != tc.expectReplace {
+				t.Fatalf("expected replace=%t, got %v", tc.expectReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestInvitationResourceDelete(t *testing.T) {
+	testCases := map[string]struct {
+		state          string
+		expectRequests []string
+	}{
+		"pending":  {state: "pending", expectRequests: []string{"POST /user_management/invitations/invitation_123/revoke"}},
+		"accepted": {state: "accepted"},
+		"expired":  {state: "expired"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			r := newInvitationResourceForTest(t, func(w http.ResponseWriter, req *http.Request) {
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":"invitation_123","state":"revoked"}`))
+			})
+
+			state := testResourceState(t, r, invitationValues(tc.state, false))
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if fmt.Sprint(requests) != fmt.Sprint(tc.expectRequests) {
+				t.Fatalf("expected requests %v, got %v", tc.expectRequests, requests)
+			}
+		})
+	}
+}
@@ -5,12 +5,15 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,6 +24,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &OrganizationResource{}
 var _ resource.ResourceWithImportState = &OrganizationResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationResource{}
+var _ resource.ResourceWithValidateConfig = &OrganizationResource{}
+var _ resource.ResourceWithUpgradeState = &OrganizationResource{}
 
 func NewOrganizationResource() resource.Resource {
 	return &OrganizationResource{}
@@ -33,13 +39,19 @@ type OrganizationResource struct {
 
 // OrganizationResourceModel describes the resource data model.
 type OrganizationResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	ExternalID types.String `tfsdk:"external_id"`
-	Metadata   types.Map    `tfsdk:"metadata"`
-	Domains    types.Set    `tfsdk:"domains"`
-	CreatedAt  types.String `tfsdk:"created_at"`
-	UpdatedAt  types.String `tfsdk:"updated_at"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	ExternalID       types.String `tfsdk:"external_id"`
+	StripeCustomerID types.String `tfsdk:"stripe_customer_id"`
+	Metadata         types.Map    `tfsdk:"metadata"`
+	MetadataAll      types.Map    `tfsdk:"metadata_all"`
+	Domains          types.Set    `tfsdk:"domains"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+
+	CascadeDelete             types.Bool `tfsdk:"cascade_delete"`
+	CascadeDeleteIntegrations types.Bool `tfsdk:"cascade_delete_integrations"`
+	AdoptExisting             types.Bool `tfsdk:"adopt_existing"`
 }
 
 func (r *OrganizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,6 +60,7 @@ func (r *OrganizationResource) Metadata(ctx context.Context, req resource.Metada
 
 func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     organizationSchemaVersion,
 		Description: "Manages a WorkOS Organization.",
 		MarkdownDescription: `
 Manages a WorkOS Organization.
@@ -91,18 +104,50 @@ terraform import workos_organization.example org_01HXYZ...
 				MarkdownDescription: "The external ID of the organization. Use this to map the organization to an entity in your application.",
 				Optional:            true,
 			},
+			"stripe_customer_id": schema.StringAttribute{
+				Description:         "The Stripe customer ID associated with the organization.",
+				MarkdownDescription: "The Stripe customer ID associated with the organization (e.g., `cus_...`). Used by WorkOS entitlements to link the organization to its Stripe billing customer. Removing it from the configuration clears it on the organization.",
+				Optional:            true,
+			},
 			"metadata": schema.MapAttribute{
 				Description:         "Metadata key/value pairs associated with the organization.",
-				MarkdownDescription: "Metadata key/value pairs associated with the organization. Maximum of 10 key/value pairs.",
+				MarkdownDescription: "Metadata key/value pairs associated with the organization. Maximum of 10 key/value pairs, including keys inherited from the provider's `default_metadata`.",
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"metadata_all": schema.MapAttribute{
+				Description:         "The organization's metadata including keys inherited from the provider's default_metadata.",
+				MarkdownDescription: "The organization's metadata including keys inherited from the provider's `default_metadata`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"domains": schema.SetAttribute{
 				Description:         "The domains associated with the organization.",
 				MarkdownDescription: "The domains associated with the organization. These are used for domain-based SSO routing.",
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Description:         "Whether to adopt an existing organization with the same external ID or domains instead of failing on create.",
+				MarkdownDescription: "Whether to adopt an existing organization with the same `external_id` or `domains` instead of failing on create. The existing organization is found by external ID first, then by domain, and is updated to match the configuration. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"cascade_delete": schema.BoolAttribute{
+				Description:         "Whether to delete the organization's memberships and revoke its pending invitations before deleting the organization.",
+				MarkdownDescription: "Whether to delete the organization's memberships and revoke its pending invitations before deleting the organization. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"cascade_delete_integrations": schema.BoolAttribute{
+				Description:         "Whether to also delete the organization's SSO connections and directories before deleting the organization.",
+				MarkdownDescription: "Whether to also delete the organization's SSO connections and directories before deleting the organization. Requires `cascade_delete` to be `true`. A plan that destroys the organization lists the connections and directories that will be deleted. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"created_at": schema.StringAttribute{
 				Description:         "The timestamp when the organization was created.",
 				MarkdownDescription: "The timestamp when the organization was created (RFC3339 format).",
@@ -121,6 +166,7 @@ terraform import workos_organization.example org_01HXYZ...
 							path.Root("name"),
 							path.Root("domains"),
 							path.Root("external_id"),
+							path.Root("stripe_customer_id"),
 							path.Root("metadata"),
 						},
 					},
@@ -130,6 +176,28 @@ terraform import workos_organization.example org_01HXYZ...
 	}
 }
 
+func (r *OrganizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cascadeDelete, cascadeDeleteIntegrations types.Bool
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cascade_delete"), &cascadeDelete)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cascade_delete_integrations"), &cascadeDeleteIntegrations)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if cascadeDelete.IsUnknown() || !cascadeDeleteIntegrations.ValueBool() {
+		return
+	}
+
+	if !cascadeDelete.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cascade_delete_integrations"),
+			"Invalid Cascade Delete Configuration",
+			"cascade_delete_integrations can only be enabled when cascade_delete is also set to true.",
+		)
+	}
+}
+
 func (r *OrganizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -150,6 +218,8 @@ func (r *OrganizationResource) Configure(ctx context.Context, req resource.Confi
 }
 
 func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan OrganizationResourceModel
 
 	// Read Terraform plan data into the model
@@ -173,10 +243,15 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		createReq.ExternalID = plan.ExternalID.ValueString()
 	}
 
-	// Add metadata if specified
-	if !plan.Metadata.IsNull() && !plan.Metadata.IsUnknown() {
+	// Add stripe_customer_id if specified
+	if !plan.StripeCustomerID.IsNull() && !plan.StripeCustomerID.IsUnknown() {
+		createReq.StripeCustomerID = plan.StripeCustomerID.ValueString()
+	}
+
+	// Add metadata, including the provider's default metadata, if specified
+	if !plan.MetadataAll.IsNull() && !plan.MetadataAll.IsUnknown() {
 		metadata := make(map[string]string)
-		resp.Diagnostics.Append(plan.Metadata.ElementsAs(ctx, &metadata, false)...)
+		resp.Diagnostics.Append(plan.MetadataAll.ElementsAs(ctx, &metadata, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -201,6 +276,20 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Create the organization
 	org, err := r.client.CreateOrganization(ctx, createReq)
+	if err != nil && plan.AdoptExisting.ValueBool() && isOrganizationConflict(err) {
+		tflog.Info(ctx, "Organization already exists, adopting existing organization", map[string]any{
+			"name": plan.Name.ValueString(),
+		})
+
+		org, err = r.adoptExistingOrganization(ctx, &plan, createReq)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Adopting Organization",
+				"Could not adopt existing organization "+plan.Name.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Organization",
@@ -219,15 +308,18 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		plan.ExternalID = types.StringValue(org.ExternalID)
 	}
 
+	// Map stripe_customer_id from response
+	if org.StripeCustomerID != "" {
+		plan.StripeCustomerID = types.StringValue(org.StripeCustomerID)
+	}
+
 	// Map metadata from response
-	if len(org.Metadata) > 0 {
-		metadataMap, diags := types.MapValueFrom(ctx, types.StringType, org.Metadata)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		plan.Metadata = metadataMap
+	metadataAll, diags := metadataValue(ctx, org.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	plan.MetadataAll = metadataAll
 
 	tflog.Info(ctx, "Created organization", map[string]any{
 		"id":   org.ID,
@@ -239,6 +331,8 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state OrganizationResourceModel
 
 	// Read Terraform prior state data into the model
@@ -255,7 +349,7 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 	// Get the organization from API
 	org, err := r.client.GetOrganization(ctx, state.ID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			tflog.Info(ctx, "Organization not found, removing from state", map[string]any{
 				"id": state.ID.ValueString(),
 			})
@@ -282,18 +376,24 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 		state.ExternalID = types.StringNull()
 	}
 
-	// Map metadata
-	if len(org.Metadata) > 0 {
-		metadataMap, diags := types.MapValueFrom(ctx, types.StringType, org.Metadata)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		state.Metadata = metadataMap
+	// Map stripe_customer_id
+	if org.StripeCustomerID != "" {
+		state.StripeCustomerID = types.StringValue(org.StripeCustomerID)
 	} else {
-		state.Metadata = types.MapNull(types.StringType)
+		state.StripeCustomerID = types.StringNull()
 	}
 
+	// Map metadata, leaving keys inherited from default_metadata to metadata_all
+	metadata, diags := configuredMetadata(ctx, defaultMetadata(r.client), org.Metadata, state.Metadata)
+	resp.Diagnostics.Append(diags...)
+	metadataAll, diags := metadataValue(ctx, org.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Metadata = metadata
+	state.MetadataAll = metadataAll
+
 	// Map domains
 	if len(org.Domains) > 0 {
 		domainStrings := make([]string, len(org.Domains))
@@ -310,11 +410,24 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 		state.Domains = types.SetNull(types.StringType)
 	}
 
+	// Cascade settings are provider-side only; default them after import
+	if state.CascadeDelete.IsNull() {
+		state.CascadeDelete = types.BoolValue(false)
+	}
+	if state.CascadeDeleteIntegrations.IsNull() {
+		state.CascadeDeleteIntegrations = types.BoolValue(false)
+	}
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan OrganizationResourceModel
 	var state OrganizationResourceModel
 
@@ -332,7 +445,7 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	})
 
 	// Skip update if no user-configurable attributes changed
-	if plan.Name.Equal(state.Name) && plan.Domains.Equal(state.Domains) && plan.ExternalID.Equal(state.ExternalID) && plan.Metadata.Equal(state.Metadata) {
+	if plan.Name.Equal(state.Name) && plan.Domains.Equal(state.Domains) && plan.ExternalID.Equal(state.ExternalID) && plan.StripeCustomerID.Equal(state.StripeCustomerID) && plan.MetadataAll.Equal(state.MetadataAll) {
 		plan.ID = state.ID
 		plan.CreatedAt = state.CreatedAt
 		plan.UpdatedAt = state.UpdatedAt
@@ -350,33 +463,20 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 		updateReq.ExternalID = plan.ExternalID.ValueString()
 	}
 
-	// WorkOS merges metadata on update — removed keys must be sent as null.
-	if !plan.Metadata.Equal(state.Metadata) && !plan.Metadata.IsUnknown() {
-		updateMap := make(map[string]*string)
-		if !plan.Metadata.IsNull() {
-			newMetadata := make(map[string]string)
-			resp.Diagnostics.Append(plan.Metadata.ElementsAs(ctx, &newMetadata, false)...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			for k, v := range newMetadata {
-				v := v
-				updateMap[k] = &v
-			}
-		}
-		if !state.Metadata.IsNull() && !state.Metadata.IsUnknown() {
-			oldMetadata := make(map[string]string)
-			resp.Diagnostics.Append(state.Metadata.ElementsAs(ctx, &oldMetadata, false)...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			for key := range oldMetadata {
-				if _, exists := updateMap[key]; !exists {
-					updateMap[key] = nil
-				}
-			}
+	// Send stripe_customer_id when it changed; removing it from the
+	// configuration sends an empty string to clear it.
+	if !plan.StripeCustomerID.Equal(state.StripeCustomerID) && !plan.StripeCustomerID.IsUnknown() {
+		stripeCustomerID := plan.StripeCustomerID.ValueString()
+		updateReq.StripeCustomerID = &stripeCustomerID
+	}
+
+	if !plan.MetadataAll.Equal(state.MetadataAll) && !plan.MetadataAll.IsUnknown() {
+		metadata, diags := metadataUpdate(ctx, plan.MetadataAll, state.MetadataAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		updateReq.Metadata = updateMap
+		updateReq.Metadata = metadata
 	}
 
 	// Add domains if specified
@@ -415,15 +515,18 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 		plan.ExternalID = types.StringValue(org.ExternalID)
 	}
 
+	// Map stripe_customer_id from response
+	if org.StripeCustomerID != "" {
+		plan.StripeCustomerID = types.StringValue(org.StripeCustomerID)
+	}
+
 	// Map metadata from response
-	if len(org.Metadata) > 0 {
-		metadataMap, diags := types.MapValueFrom(ctx, types.StringType, org.Metadata)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		plan.Metadata = metadataMap
+	metadataAll, diags := metadataValue(ctx, org.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	plan.MetadataAll = metadataAll
 
 	tflog.Info(ctx, "Updated organization", map[string]any{
 		"id":   org.ID,
@@ -435,6 +538,8 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state OrganizationResourceModel
 
 	// Read Terraform prior state data into the model
@@ -448,6 +553,27 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 		"id": state.ID.ValueString(),
 	})
 
+	if state.CascadeDelete.ValueBool() {
+		if err := r.cascadeDelete(ctx, state.ID.ValueString(), state.CascadeDeleteIntegrations.ValueBool()); err != nil {
+			// A 404 from a child endpoint only means the organization is gone
+			// if the organization itself can no longer be found.
+			if client.IsNotFound(err) {
+				if _, getErr := r.client.GetOrganization(ctx, state.ID.ValueString()); client.IsNotFound(getErr) {
+					tflog.Info(ctx, "Organization already deleted", map[string]any{
+						"id": state.ID.ValueString(),
+					})
+					return
+				}
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Deleting Organization",
+				"Could not delete dependent objects of organization "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Delete the organization
 	err := r.client.DeleteOrganization(ctx, state.ID.ValueString())
 	if err != nil {
@@ -471,6 +597,260 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 	})
 }
 
+// organizationConflictCodes are the API error codes returned when an
+// organization cannot be created because its identifiers are already in use.
+var organizationConflictCodes = map[string]bool{
+	"organization_domain_already_used": true,
+	"external_id_already_used":         true,
+}
+
+// isOrganizationConflict reports whether a create organization error was
+// caused by the external ID or a domain already belonging to an organization.
+func isOrganizationConflict(err error) bool {
+	if client.IsConflict(err) {
+		return true
+	}
+
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if organizationConflictCodes[apiErr.Code] {
+		return true
+	}
+	for _, ve := range apiErr.Errors {
+		if organizationConflictCodes[ve.Code] {
+			return true
+		}
+	}
+	return false
+}
+
+// findExistingOrganization looks up the organization that owns the planned
+// external ID or domains. All identifiers must point at the same organization.
+func (r *OrganizationResource) findExistingOrganization(ctx context.Context, createReq *client.OrganizationCreateRequest) (*client.Organization, error) {
+	var existing *client.Organization
+
+	if createReq.ExternalID != "" {
+		org, err := r.client.GetOrganizationByExternalID(ctx, createReq.ExternalID)
+		if err != nil && !client.IsNotFound(err) {
+			return nil, err
+		}
+		existing = org
+	}
+
+	for _, domain := range createReq.DomainData {
+		org, err := r.client.GetOrganizationByDomain(ctx, domain.Domain)
+		if err != nil {
+			if client.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if existing == nil {
+			existing = org
+			continue
+		}
+		if existing.ID != org.ID {
+			return nil, fmt.Errorf("domain %s belongs to organization %s, but the configuration also matches organization %s", domain.Domain, org.ID, existing.ID)
+		}
+	}
+
+	if existing == nil {
+		return nil, fmt.Errorf("no existing organization matches the configured external_id or domains")
+	}
+	return existing, nil
+}
+
+// adoptExistingOrganization finds the organization that conflicts with the
+// planned one and updates it to match the plan.
+func (r *OrganizationResource) adoptExistingOrganization(ctx context.Context, plan *OrganizationResourceModel, createReq *client.OrganizationCreateRequest) (*client.Organization, error) {
+	existing, err := r.findExistingOrganization(ctx, createReq)
+	if err != nil {
+		return nil, err
+	}
+
+	updateReq := &client.OrganizationUpdateRequest{
+		Name:       createReq.Name,
+		DomainData: createReq.DomainData,
+		ExternalID: createReq.ExternalID,
+	}
+
+	// Clear a Stripe customer the plan does not have.
+	if createReq.StripeCustomerID != existing.StripeCustomerID {
+		stripeCustomerID := createReq.StripeCustomerID
+		updateReq.StripeCustomerID = &stripeCustomerID
+	}
+
+	if !plan.MetadataAll.IsUnknown() {
+		// Replace the existing metadata — keys not in the plan are sent as null.
+		updateMap := make(map[string]*string)
+		for k, v := range createReq.Metadata {
+			v := v
+			updateMap[k] = &v
+		}
+		for key := range existing.Metadata {
+			if _, exists := updateMap[key]; !exists {
+				updateMap[key] = nil
+			}
+		}
+		if len(updateMap) > 0 {
+			updateReq.Metadata = updateMap
+		}
+	}
+
+	return r.client.UpdateOrganization(ctx, existing.ID, updateReq)
+}
+
+// cascadeDelete removes the objects that belong to an organization so that the
+// organization itself can be deleted without leaving orphans behind.
+func (r *OrganizationResource) cascadeDelete(ctx context.Context, organizationID string, integrations bool) error {
+	memberships, err := r.client.ListOrganizationMemberships(ctx, "", organizationID)
+	if err != nil {
+		return err
+	}
+	for _, membership := range memberships.Data {
+		tflog.Debug(ctx, "Cascade deleting organization membership", map[string]any{
+			"organization_id": organizationID,
+			"membership_id":   membership.ID,
+		})
+		if err := r.client.DeleteOrganizationMembership(ctx, membership.ID); err != nil && !client.IsNotFound(err) {
+			return err
+		}
+	}
+
+	invitations, err := r.client.ListInvitations(ctx, "", organizationID)
+	if err != nil {
+		return err
+	}
+	for _, invitation := range invitations.Data {
+		if invitation.State != "pending" {
+			continue
+		}
+		tflog.Debug(ctx, "Cascade revoking organization invitation", map[string]any{
+			"organization_id": organizationID,
+			"invitation_id":   invitation.ID,
+		})
+		if _, err := r.client.RevokeInvitation(ctx, invitation.ID); err != nil && !client.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if !integrations {
+		return nil
+	}
+
+	connections, err := r.client.ListConnections(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	for _, connection := range connections.Data {
+		tflog.Debug(ctx, "Cascade deleting organization connection", map[string]any{
+			"organization_id": organizationID,
+			"connection_id":   connection.ID,
+		})
+		if err := r.client.DeleteConnection(ctx, connection.ID); err != nil && !client.IsNotFound(err) {
+			return err
+		}
+	}
+
+	directories, err := r.client.ListDirectories(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	for _, directory := range directories.Data {
+		tflog.Debug(ctx, "Cascade deleting organization directory", map[string]any{
+			"organization_id": organizationID,
+			"directory_id":    directory.ID,
+		})
+		if err := r.client.DeleteDirectory(ctx, directory.ID); err != nil && !client.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *OrganizationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planMetadataAll(ctx, r.client, req, resp)
+	r.warnCascadeDeleteIntegrations(ctx, req, resp)
+}
+
+// warnCascadeDeleteIntegrations lists the SSO connections and directories a
+// planned destroy will delete when cascade_delete_integrations is enabled.
+// They are usually managed outside this resource, so the plan names each one
+// before anything is deleted.
+func (r *OrganizationResource) warnCascadeDeleteIntegrations(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var state OrganizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !state.CascadeDelete.ValueBool() || !state.CascadeDeleteIntegrations.ValueBool() {
+		return
+	}
+
+	organizationID := state.ID.ValueString()
+	integrations, err := r.listIntegrations(ctx, organizationID)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to List Organization Integrations",
+			"Destroying organization "+organizationID+" deletes its SSO connections and directories because cascade_delete_integrations is enabled, "+
+				"but they could not be listed for this plan: "+err.Error(),
+		)
+		return
+	}
+	if len(integrations) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Organization Integrations Will Be Deleted",
+		"Destroying organization "+organizationID+" also deletes the following because cascade_delete_integrations is enabled:\n\n"+
+			strings.Join(integrations, "\n")+"\n\n"+
+			"To keep them, set cascade_delete_integrations to false and apply before destroying the organization.",
+	)
+}
+
+// listIntegrations describes each SSO connection and directory of an
+// organization, one line per object.
+func (r *OrganizationResource) listIntegrations(ctx context.Context, organizationID string) ([]string, error) {
+	connections, err := r.client.ListConnections(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	directories, err := r.client.ListDirectories(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var integrations []string
+	for _, connection := range connections.Data {
+		integrations = append(integrations, describeIntegration("SSO connection", connection.ID, connection.Name, connection.ConnectionType))
+	}
+	for _, directory := range directories.Data {
+		integrations = append(integrations, describeIntegration("directory", directory.ID, directory.Name, directory.Type))
+	}
+	return integrations, nil
+}
+
+// describeIntegration formats one line of the cascade delete warning.
+func describeIntegration(kind, id, name, integrationType string) string {
+	line := "  - " + kind + " " + id
+	if name != "" {
+		line += " (" + name
+		if integrationType != "" {
+			line += ", " + integrationType
+		}
+		line += ")"
+	}
+	return line
+}
+
 func (r *OrganizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Importing organization", map[string]any{
 		"id": req.ID,
@@ -478,3 +858,7 @@ func (r *OrganizationResource) ImportState(ctx context.Context, req resource.Imp
 
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *OrganizationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
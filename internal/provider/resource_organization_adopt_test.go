@@ -0,0 +1,149 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// organizationAdoptServer rejects organization creation with a conflict and
+// serves the lookups and update made when adopting the organization.
+type organizationAdoptServer struct {
+	t          *testing.T
+	domainOrg  string
+	requests   []string
+	updateBody map[string]any
+}
+
+func (s *organizationAdoptServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.Method + " " + r.URL.Path {
+	case "POST /organizations":
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"code":"organization_domain_already_used","message":"Domain is already used by another organization."}`))
+	case "GET /organizations/external_id/acme-123":
+		_, _ = w.Write([]byte(`{"id":"org_existing","name":"Acme Old","external_id":"acme-123","metadata":{"stale":"value"}}`))
+	case "GET /organizations":
+		if got := r.URL.Query().Get("domains"); got != "acme.com" {
+			s.t.Fatalf("expected domains=acme.com, got %q", got)
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"` + s.domainOrg + `","name":"Acme Old"}],"list_metadata":{}}`))
+	case "PUT /organizations/org_existing":
+		if err := json.NewDecoder(r.Body).Decode(&s.updateBody); err != nil {
+			s.t.Fatalf("failed to decode request body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{
+  "id": "org_existing",
+  "name": "Acme",
+  "external_id": "acme-123",
+  "domains": [{"domain": "acme.com"}],
+  "created_at": "2026-01-15T12:00:00.000Z",
+  "updated_at": "2026-01-16T12:00:00.000Z"
+}`))
+	default:
+		s.t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func createOrganizationForAdoptTest(t *testing.T, serverURL string, adoptExisting bool) (*resource.CreateResponse, OrganizationResourceModel) {
+	t.Helper()
+
+	c, err := client.NewClient("sk_test", "", serverURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &OrganizationResource{client: c}
+
+	plan := testResourceState(t, r, map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name":           tftypes.NewValue(tftypes.String, "Acme"),
+		"external_id":    tftypes.NewValue(tftypes.String, "acme-123"),
+		"adopt_existing": tftypes.NewValue(tftypes.Bool, adoptExisting),
+		"domains": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "acme.com"),
+		}),
+	})
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: plan.Schema,
+			Raw:    tftypes.NewValue(plan.Raw.Type(), nil),
+		},
+	}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	var state OrganizationResourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	}
+	return resp, state
+}
+
+func TestOrganizationResourceCreateAdoptsExistingOrganization(t *testing.T) {
+	fake := &organizationAdoptServer{t: t, domainOrg: "org_existing"}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	resp, state := createOrganizationForAdoptTest(t, server.URL, true)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if state.ID.ValueString() != "org_existing" {
+		t.Fatalf("unexpected ID: %s", state.ID.ValueString())
+	}
+	if fake.updateBody["name"] != "Acme" {
+		t.Fatalf("expected name to be updated, got %#v", fake.updateBody)
+	}
+	metadata, ok := fake.updateBody["metadata"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected metadata in update body, got %#v", fake.updateBody)
+	}
+	if value, exists := metadata["stale"]; !exists || value != nil {
+		t.Fatalf("expected stale metadata key to be sent as null, got %#v", metadata)
+	}
+}
+
+func TestOrganizationResourceCreateRejectsAmbiguousAdoption(t *testing.T) {
+	fake := &organizationAdoptServer{t: t, domainOrg: "org_other"}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	resp, _ := createOrganizationForAdoptTest(t, server.URL, true)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when external_id and domain match different organizations")
+	}
+	for _, request := range fake.requests {
+		if request == "PUT /organizations/org_existing" {
+			t.Fatalf("expected no update, got %v", fake.requests)
+		}
+	}
+}
+
+func TestOrganizationResourceCreateDoesNotAdoptWhenDisabled(t *testing.T) {
+	fake := &organizationAdoptServer{t: t, domainOrg: "org_existing"}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	resp, _ := createOrganizationForAdoptTest(t, server.URL, false)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected create error when adopt_existing is false")
+	}
+	if len(fake.requests) != 1 {
+		t.Fatalf("expected only the create request, got %v", fake.requests)
+	}
+}
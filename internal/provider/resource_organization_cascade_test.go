@@ -0,0 +1,257 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// organizationCascadeServer fakes the endpoints touched by a cascading
+// organization delete and records every request it receives.
+type organizationCascadeServer struct {
+	t        *testing.T
+	mu       sync.Mutex
+	requests []string
+	// notFound lists "METHOD path" requests that should answer with a 404.
+	notFound map[string]bool
+}
+
+func (s *organizationCascadeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := r.Method + " " + r.URL.Path
+
+	s.mu.Lock()
+	s.requests = append(s.requests, key)
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if s.notFound[key] {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not found"}`))
+		return
+	}
+
+	switch key {
+	case "GET /user_management/organization_memberships":
+		if got := r.URL.Query().Get("organization_id"); got != "org_123" {
+			s.t.Fatalf("expected organization_id=org_123, got %q", got)
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"om_1"},{"id":"om_2"}],"list_metadata":{}}`))
+	case "GET /user_management/invitations":
+		_, _ = w.Write([]byte(`{"data":[{"id":"invitation_pending","state":"pending"},{"id":"invitation_accepted","state":"accepted"}],"list_metadata":{}}`))
+	case "GET /connections":
+		_, _ = w.Write([]byte(`{"data":[{"id":"conn_1"}],"list_metadata":{}}`))
+	case "GET /directories":
+		_, _ = w.Write([]byte(`{"data":[{"id":"directory_1"}],"list_metadata":{}}`))
+	case "GET /organizations/org_123":
+		_, _ = w.Write([]byte(`{"id":"org_123","name":"Acme"}`))
+	case "POST /user_management/invitations/invitation_pending/revoke":
+		_, _ = w.Write([]byte(`{"id":"invitation_pending","state":"revoked"}`))
+	default:
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		s.t.Fatalf("unexpected request: %s", key)
+	}
+}
+
+func (s *organizationCascadeServer) indexOf(key string) int {
+	for i, request := range s.requests {
+		if request == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func newOrganizationCascadeResource(t *testing.T, server *httptest.Server) *OrganizationResource {
+	t.Helper()
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return &OrganizationResource{client: c}
+}
+
+func TestOrganizationResourceCascadeDeleteMembershipsAndInvitations(t *testing.T) {
+	fake := &organizationCascadeServer{t: t}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	r := newOrganizationCascadeResource(t, server)
+	if err := r.cascadeDelete(context.Background(), "org_123", false); err != nil {
+		t.Fatalf("cascadeDelete returned error: %v", err)
+	}
+
+	lastMembershipDelete := fake.indexOf("DELETE /user_management/organization_memberships/om_2")
+	if fake.indexOf("DELETE /user_management/organization_memberships/om_1") < 0 || lastMembershipDelete < 0 {
+		t.Fatalf("expected both memberships to be deleted, got %v", fake.requests)
+	}
+	if listInvitations := fake.indexOf("GET /user_management/invitations"); listInvitations < lastMembershipDelete {
+		t.Fatalf("expected memberships to be deleted before invitations are revoked, got %v", fake.requests)
+	}
+	if fake.indexOf("POST /user_management/invitations/invitation_pending/revoke") < 0 {
+		t.Fatalf("expected pending invitation to be revoked, got %v", fake.requests)
+	}
+	if fake.indexOf("POST /user_management/invitations/invitation_accepted/revoke") >= 0 {
+		t.Fatalf("expected accepted invitation to be left alone, got %v", fake.requests)
+	}
+	for _, request := range fake.requests {
+		if strings.Contains(request, "/connections") || strings.Contains(request, "/directories") {
+			t.Fatalf("expected integrations to be left alone, got %v", fake.requests)
+		}
+	}
+}
+
+func TestOrganizationResourceCascadeDeleteIntegrationsIgnoresNotFound(t *testing.T) {
+	fake := &organizationCascadeServer{
+		t: t,
+		notFound: map[string]bool{
+			"DELETE /user_management/organization_memberships/om_1": true,
+			"DELETE /connections/conn_1":                            true,
+		},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	r := newOrganizationCascadeResource(t, server)
+	if err := r.cascadeDelete(context.Background(), "org_123", true); err != nil {
+		t.Fatalf("cascadeDelete returned error: %v", err)
+	}
+
+	for _, key := range []string{
+		"DELETE /user_management/organization_memberships/om_2",
+		"DELETE /connections/conn_1",
+		"DELETE /directories/directory_1",
+	} {
+		if fake.indexOf(key) < 0 {
+			t.Fatalf("expected %s, got %v", key, fake.requests)
+		}
+	}
+}
+
+func TestOrganizationResourceDeleteKeepsOrganizationOnChildNotFound(t *testing.T) {
+	fake := &organizationCascadeServer{
+		t: t,
+		notFound: map[string]bool{
+			"GET /user_management/invitations": true,
+		},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	r := newOrganizationCascadeResource(t, server)
+	resp := &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{
+		State: testResourceState(t, &OrganizationResource{}, map[string]tftypes.Value{
+			"id":                          tftypes.NewValue(tftypes.String, "org_123"),
+			"cascade_delete":              tftypes.NewValue(tftypes.Bool, true),
+			"cascade_delete_integrations": tftypes.NewValue(tftypes.Bool, false),
+		}),
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when a child endpoint 404s but the organization still exists")
+	}
+	if fake.indexOf("DELETE /organizations/org_123") >= 0 {
+		t.Fatalf("expected organization delete to be skipped, got %v", fake.requests)
+	}
+}
+
+func TestOrganizationResourceValidateConfigRequiresCascadeDelete(t *testing.T) {
+	testCases := map[string]struct {
+		cascadeDelete tftypes.Value
+		expectError   bool
+	}{
+		"unset":   {cascadeDelete: tftypes.NewValue(tftypes.Bool, nil), expectError: true},
+		"false":   {cascadeDelete: tftypes.NewValue(tftypes.Bool, false), expectError: true},
+		"true":    {cascadeDelete: tftypes.NewValue(tftypes.Bool, true), expectError: false},
+		"unknown": {cascadeDelete: tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue), expectError: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := testResourceState(t, &OrganizationResource{}, map[string]tftypes.Value{
+				"cascade_delete":              tc.cascadeDelete,
+				"cascade_delete_integrations": tftypes.NewValue(tftypes.Bool, true),
+			})
+
+			r := &OrganizationResource{}
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%t, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestOrganizationResourceModifyPlanWarnsIntegrationsOnDestroy(t *testing.T) {
+	testCases := map[string]struct {
+		cascadeDeleteIntegrations bool
+		expectWarning             bool
+	}{
+		"enabled":  {cascadeDeleteIntegrations: true, expectWarning: true},
+		"disabled": {cascadeDeleteIntegrations: false, expectWarning: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fake := &organizationCascadeServer{t: t}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			r := newOrganizationCascadeResource(t, server)
+			state := testResourceState(t, r, map[string]tftypes.Value{
+				"id":                          tftypes.NewValue(tftypes.String, "org_123"),
+				"cascade_delete":              tftypes.NewValue(tftypes.Bool, true),
+				"cascade_delete_integrations": tftypes.NewValue(tftypes.Bool, tc.cascadeDeleteIntegrations),
+			})
+			destroy := tfsdk.Plan{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)}
+
+			resp := &resource.ModifyPlanResponse{Plan: destroy}
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)},
+				Plan:   destroy,
+				State:  state,
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if !tc.expectWarning {
+				if len(resp.Diagnostics) != 0 || len(fake.requests) != 0 {
+					t.Fatalf("expected no warning and no requests, got %v and %v", resp.Diagnostics, fake.requests)
+				}
+				return
+			}
+			if len(resp.Diagnostics) != 1 {
+				t.Fatalf("expected one warning, got %v", resp.Diagnostics)
+			}
+			detail := resp.Diagnostics[0].Detail()
+			if !strings.Contains(detail, "SSO connection conn_1") || !strings.Contains(detail, "directory directory_1") {
+				t.Fatalf("expected the warning to list the connection and directory, got %q", detail)
+			}
+			for _, request := range fake.requests {
+				if strings.HasPrefix(request, "DELETE") {
+					t.Fatalf("expected planning not to delete anything, got %v", fake.requests)
+				}
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ import (
 
 var _ resource.Resource = &OrganizationDomainResource{}
 var _ resource.ResourceWithImportState = &OrganizationDomainResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationDomainResource{}
+var _ resource.ResourceWithUpgradeState = &OrganizationDomainResource{}
 
 func NewOrganizationDomainResource() resource.Resource {
 	return &OrganizationDomainResource{}
@@ -28,16 +30,18 @@ type OrganizationDomainResource struct {
 }
 
 type OrganizationDomainResourceModel struct {
-	ID                   types.String `tfsdk:"id"`
-	OrganizationID       types.String `tfsdk:"organization_id"`
-	Domain               types.String `tfsdk:"domain"`
-	Verify               types.Bool   `tfsdk:"verify"`
-	State                types.String `tfsdk:"state"`
-	VerificationPrefix   types.String `tfsdk:"verification_prefix"`
-	VerificationToken    types.String `tfsdk:"verification_token"`
-	VerificationStrategy types.String `tfsdk:"verification_strategy"`
-	CreatedAt            types.String `tfsdk:"created_at"`
-	UpdatedAt            types.String `tfsdk:"updated_at"`
+	ID                      types.String `tfsdk:"id"`
+	OrganizationID          types.String `tfsdk:"organization_id"`
+	Domain                  types.String `tfsdk:"domain"`
+	Verify                  types.Bool   `tfsdk:"verify"`
+	State                   types.String `tfsdk:"state"`
+	VerificationPrefix      types.String `tfsdk:"verification_prefix"`
+	VerificationToken       types.String `tfsdk:"verification_token"`
+	VerificationStrategy    types.String `tfsdk:"verification_strategy"`
+	VerificationRecordName  types.String `tfsdk:"verification_record_name"`
+	VerificationRecordValue types.String `tfsdk:"verification_record_value"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+	UpdatedAt               types.String `tfsdk:"updated_at"`
 }
 
 func (r *OrganizationDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,6 +50,7 @@ func (r *OrganizationDomainResource) Metadata(ctx context.Context, req resource.
 
 func (r *OrganizationDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     organizationDomainSchemaVersion,
 		Description: "Manages a WorkOS Organization Domain.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -93,6 +98,14 @@ func (r *OrganizationDomainResource) Schema(ctx context.Context, req resource.Sc
 				Description: "The verification strategy for the domain.",
 				Computed:    true,
 			},
+			"verification_record_name": schema.StringAttribute{
+				Description: "The fully qualified name of the DNS TXT record that verifies the domain.",
+				Computed:    true,
+			},
+			"verification_record_value": schema.StringAttribute{
+				Description: "The value of the DNS TXT record that verifies the domain.",
+				Computed:    true,
+			},
 			"created_at": schema.StringAttribute{
 				Description: "The timestamp when the organization domain was created.",
 				Computed:    true,
@@ -123,6 +136,8 @@ func (r *OrganizationDomainResource) Configure(ctx context.Context, req resource
 }
 
 func (r *OrganizationDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan OrganizationDomainResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -151,6 +166,8 @@ func (r *OrganizationDomainResource) Create(ctx context.Context, req resource.Cr
 }
 
 func (r *OrganizationDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state OrganizationDomainResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -159,7 +176,7 @@ func (r *OrganizationDomainResource) Read(ctx context.Context, req resource.Read
 
 	domain, err := r.client.GetOrganizationDomain(ctx, state.ID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -172,6 +189,8 @@ func (r *OrganizationDomainResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *OrganizationDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan OrganizationDomainResourceModel
 	var state OrganizationDomainResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -197,6 +216,8 @@ func (r *OrganizationDomainResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *OrganizationDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state OrganizationDomainResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -213,6 +234,10 @@ func (r *OrganizationDomainResource) Delete(ctx context.Context, req resource.De
 	}
 }
 
+func (r *OrganizationDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateReferences(ctx, r.client, req, resp, "organization_id")
+}
+
 func (r *OrganizationDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -221,13 +246,19 @@ func organizationDomainToState(state *OrganizationDomainResourceModel, domain *c
 	state.ID = types.StringValue(domain.ID)
 	state.OrganizationID = types.StringValue(domain.OrganizationID)
 	state.Domain = types.StringValue(domain.Domain)
-	if state.Verify.IsUnknown() {
+	if state.Verify.IsNull() || state.Verify.IsUnknown() {
 		state.Verify = types.BoolValue(false)
 	}
 	state.State = optionalString(domain.State)
 	state.VerificationPrefix = optionalString(domain.VerificationPrefix)
 	state.VerificationToken = optionalString(domain.VerificationToken)
 	state.VerificationStrategy = optionalString(domain.VerificationStrategy)
+	state.VerificationRecordName = types.StringNull()
+	state.VerificationRecordValue = types.StringNull()
+	if !state.VerificationPrefix.IsNull() && !state.VerificationToken.IsNull() {
+		state.VerificationRecordName = types.StringValue(state.VerificationPrefix.ValueString() + "." + domain.Domain)
+		state.VerificationRecordValue = state.VerificationToken
+	}
 	state.CreatedAt = types.StringValue(domain.CreatedAt.Format(time.RFC3339))
 	state.UpdatedAt = types.StringValue(domain.UpdatedAt.Format(time.RFC3339))
 }
@@ -238,3 +269,7 @@ func optionalString(value *string) types.String {
 	}
 	return types.StringValue(*value)
 }
+
+func (r *OrganizationDomainResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
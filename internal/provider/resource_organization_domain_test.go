@@ -0,0 +1,60 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestOrganizationDomainToStateVerificationRecord(t *testing.T) {
+	prefix := "superapp-domain-verification-z3kjny"
+	token := "m5Oztg3jdK4NJLgs8uIlIprMw"
+	state := &OrganizationDomainResourceModel{Verify: types.BoolUnknown()}
+
+	organizationDomainToState(state, &client.OrganizationDomain{
+		ID:                 "org_domain_01HXYZ",
+		OrganizationID:     "org_01HXYZ",
+		Domain:             "example.com",
+		VerificationPrefix: &prefix,
+		VerificationToken:  &token,
+	})
+
+	if state.VerificationRecordName.ValueString() != "superapp-domain-verification-z3kjny.example.com" {
+		t.Fatalf("unexpected record name: %s", state.VerificationRecordName.ValueString())
+	}
+	if state.VerificationRecordValue.ValueString() != token {
+		t.Fatalf("unexpected record value: %s", state.VerificationRecordValue.ValueString())
+	}
+}
+
+func TestOrganizationDomainToStateWithoutVerificationToken(t *testing.T) {
+	state := &OrganizationDomainResourceModel{Verify: types.BoolUnknown()}
+
+	organizationDomainToState(state, &client.OrganizationDomain{
+		ID:             "org_domain_01HXYZ",
+		OrganizationID: "org_01HXYZ",
+		Domain:         "example.com",
+	})
+
+	if !state.VerificationRecordName.IsNull() || !state.VerificationRecordValue.IsNull() {
+		t.Fatalf("expected null verification record, got %s=%s", state.VerificationRecordName, state.VerificationRecordValue)
+	}
+}
+
+func TestOrganizationDomainToStateDefaultsVerifyAfterImport(t *testing.T) {
+	state := &OrganizationDomainResourceModel{Verify: types.BoolNull()}
+
+	organizationDomainToState(state, &client.OrganizationDomain{
+		ID:             "org_domain_01HXYZ",
+		OrganizationID: "org_01HXYZ",
+		Domain:         "example.com",
+	})
+
+	if state.Verify.IsNull() || state.Verify.ValueBool() {
+		t.Fatalf("expected verify to default to false, got %s", state.Verify)
+	}
+}
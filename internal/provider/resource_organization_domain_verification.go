@@ -0,0 +1,294 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+var _ resource.Resource = &OrganizationDomainVerificationResource{}
+var _ resource.ResourceWithUpgradeState = &OrganizationDomainVerificationResource{}
+
+// organizationDomainVerificationPollInterval is how long to wait between
+// checks of a pending domain.
+var organizationDomainVerificationPollInterval = 10 * time.Second
+
+const organizationDomainVerificationDefaultTimeout = "10m"
+
+func NewOrganizationDomainVerificationResource() resource.Resource {
+	return &OrganizationDomainVerificationResource{}
+}
+
+// OrganizationDomainVerificationResource waits for an organization domain to
+// be verified when it is created. It is separate from
+// workos_organization_domain so that it can depend on the DNS record that
+// publishes the domain's verification token. It has no remote object of its
+// own: destroying it does nothing.
+type OrganizationDomainVerificationResource struct {
+	client *client.Client
+}
+
+type OrganizationDomainVerificationResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	OrganizationDomainID types.String `tfsdk:"organization_domain_id"`
+	Timeout              types.String `tfsdk:"timeout"`
+	Domain               types.String `tfsdk:"domain"`
+	State                types.String `tfsdk:"state"`
+	VerifiedAt           types.String `tfsdk:"verified_at"`
+}
+
+func (r *OrganizationDomainVerificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_domain_verification"
+}
+
+func (r *OrganizationDomainVerificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: organizationDomainVerificationSchemaVersion,
+		Description: "Waits for a WorkOS organization domain to be verified when created. Make it depend on the DNS record that publishes the " +
+			"domain's verification token so resources that need a verified domain are only created once verification succeeds. " +
+			"Destroying the resource does not affect the domain.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the verified organization domain.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_domain_id": schema.StringAttribute{
+				Description: "The ID of the organization domain to wait for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for the domain to be verified, as a duration such as 30m. Verification is started again " +
+					"whenever WorkOS reports it failed, until the timeout expires. Defaults to " + organizationDomainVerificationDefaultTimeout + ".",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(organizationDomainVerificationDefaultTimeout),
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: "The verified domain name.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "The domain verification state.",
+				Computed:    true,
+			},
+			"verified_at": schema.StringAttribute{
+				Description: "The timestamp when the domain was found to be verified.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationDomainVerificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *OrganizationDomainVerificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var plan OrganizationDomainVerificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.OrganizationDomainID.ValueString()
+	timeout, err := time.ParseDuration(plan.Timeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Timeout", err.Error())
+		return
+	}
+
+	domain, err := r.waitForVerification(ctx, id, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Waiting for Organization Domain Verification", "Could not verify organization domain "+id+": "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(domain.ID)
+	plan.Domain = types.StringValue(domain.Domain)
+	plan.State = optionalString(domain.State)
+	plan.VerifiedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// waitForVerification starts verification of a domain that is not verified
+// yet and polls it until it is, starting verification again each time it
+// fails, until timeout expires.
+func (r *OrganizationDomainVerificationResource) waitForVerification(ctx context.Context, id string, timeout time.Duration) (*client.OrganizationDomain, error) {
+	deadline := time.Now().Add(timeout)
+	started := false
+
+	domain, err := r.client.GetOrganizationDomain(ctx, id)
+	for {
+		if err != nil {
+			return nil, err
+		}
+		if organizationDomainVerified(domain) {
+			return domain, nil
+		}
+
+		state := "unknown"
+		if domain.State != nil {
+			state = *domain.State
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out after %s with the domain in state %q; check that its verification TXT record is published", timeout, state)
+		}
+
+		tflog.Debug(ctx, "Waiting for organization domain verification", map[string]any{
+			"id":    id,
+			"state": state,
+		})
+
+		// A pending domain is still being checked after verification was
+		// started; anything else needs verification to be started again.
+		if !started || state != "pending" {
+			started = true
+			domain, err = r.client.VerifyOrganizationDomain(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if organizationDomainVerified(domain) {
+				return domain, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(organizationDomainVerificationPollInterval):
+		}
+		domain, err = r.client.GetOrganizationDomain(ctx, id)
+	}
+}
+
+func (r *OrganizationDomainVerificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var state OrganizationDomainVerificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := r.client.GetOrganizationDomain(ctx, state.OrganizationDomainID.ValueString())
+	if err != nil {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Organization Domain", "Could not read organization domain: "+err.Error())
+		return
+	}
+
+	// A domain that is no longer verified is waited for again on the next
+	// apply.
+	if !organizationDomainVerified(domain) {
+		tflog.Warn(ctx, "Organization domain is no longer verified, removing verification from state", map[string]any{
+			"id":    domain.ID,
+			"state": optionalString(domain.State).ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Domain = types.StringValue(domain.Domain)
+	state.State = optionalString(domain.State)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OrganizationDomainVerificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OrganizationDomainVerificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationDomainVerificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationDomainVerificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removing organization domain verification from state; the domain is not affected", map[string]any{"id": state.ID.ValueString()})
+}
+
+func (r *OrganizationDomainVerificationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+// organizationDomainVerified reports whether a domain is verified, including
+// domains verified before WorkOS introduced DNS verification.
+func organizationDomainVerified(domain *client.OrganizationDomain) bool {
+	if domain.State == nil {
+		return false
+	}
+	return *domain.State == "verified" || *domain.State == "legacy_verified"
+}
+
+// durationValidator checks that a string is a Go duration such as 30m.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a duration such as 30s, 10m or 1h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestOrganizationDomainVerificationResourceCreate(t *testing.T) {
+	pollInterval := organizationDomainVerificationPollInterval
+	organizationDomainVerificationPollInterval = time.Millisecond
+	t.Cleanup(func() { organizationDomainVerificationPollInterval = pollInterval })
+
+	testCases := map[string]struct {
+		states         []string
+		timeout        string
+		expectRequests []string
+		expectError    bool
+	}{
+		"already verified": {
+			states:         []string{"verified"},
+			timeout:        "1m",
+			expectRequests: []string{"GET /organization_domains/org_domain_123"},
+		},
+		"pending until verified": {
+			states:  []string{"pending", "pending", "pending", "verified"},
+			timeout: "1m",
+			expectRequests: []string{
+				"GET /organization_domains/org_domain_123",
+				"POST /organization_domains/org_domain_123/verify",
+				"GET /organization_domains/org_domain_123",
+				"GET /organization_domains/org_domain_123",
+			},
+		},
+		"failed verification is started again": {
+			states:  []string{"pending", "pending", "failed", "verified"},
+			timeout: "1m",
+			expectRequests: []string{
+				"GET /organization_domains/org_domain_123",
+				"POST /organization_domains/org_domain_123/verify",
+				"GET /organization_domains/org_domain_123",
+				"POST /organization_domains/org_domain_123/verify",
+			},
+		},
+		"timeout": {
+			states:      []string{"pending"},
+			timeout:     "5ms",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				state := tc.states[min(len(requests), len(tc.states)-1)]
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"id":"org_domain_123","organization_id":"org_123","domain":"example.com","state":%q}`, state)
+			}))
+			defer server.Close()
+
+			c, err := client.NewClient("sk_test", "", server.URL)
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			r := &OrganizationDomainVerificationResource{client: c}
+
+			plan := testResourceState(t, r, map[string]tftypes.Value{
+				"id":                     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"organization_domain_id": tftypes.NewValue(tftypes.String, "org_domain_123"),
+				"timeout":                tftypes.NewValue(tftypes.String, tc.timeout),
+			})
+			resp := &resource.CreateResponse{State: plan}
+			r.Create(context.Background(), resource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%t, got %v", tc.expectError, resp.Diagnostics)
+			}
+			if tc.expectError {
+				return
+			}
+			if fmt.Sprint(requests) != fmt.Sprint(tc.expectRequests) {
+				t.Fatalf("expected requests %v, got %v", tc.expectRequests, requests)
+			}
+
+			var result OrganizationDomainVerificationResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+			if result.State.ValueString() != "verified" || result.Domain.ValueString() != "example.com" {
+				t.Fatalf("expected a verified domain in state, got %#v", result)
+			}
+		})
+	}
+}
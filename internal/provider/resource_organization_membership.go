@@ -6,15 +6,19 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/osodevops/terraform-provider-workos/internal/client"
@@ -24,6 +28,9 @@ import (
 var _ resource.Resource = &OrganizationMembershipResource{}
 var _ resource.ResourceWithConfigValidators = &OrganizationMembershipResource{}
 var _ resource.ResourceWithImportState = &OrganizationMembershipResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationMembershipResource{}
+var _ resource.ResourceWithValidateConfig = &OrganizationMembershipResource{}
+var _ resource.ResourceWithUpgradeState = &OrganizationMembershipResource{}
 
 func NewOrganizationMembershipResource() resource.Resource {
 	return &OrganizationMembershipResource{}
@@ -36,22 +43,31 @@ type OrganizationMembershipResource struct {
 
 // OrganizationMembershipResourceModel describes the resource data model.
 type OrganizationMembershipResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	UserID         types.String `tfsdk:"user_id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	RoleSlug       types.String `tfsdk:"role_slug"`
-	RoleSlugs      types.List   `tfsdk:"role_slugs"`
-	Status         types.String `tfsdk:"status"`
-	CreatedAt      types.String `tfsdk:"created_at"`
-	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ID               types.String `tfsdk:"id"`
+	UserID           types.String `tfsdk:"user_id"`
+	OrganizationID   types.String `tfsdk:"organization_id"`
+	RoleSlug         types.String `tfsdk:"role_slug"`
+	RoleSlugs        types.List   `tfsdk:"role_slugs"`
+	ProvisioningMode types.String `tfsdk:"provisioning_mode"`
+	InvitationID     types.String `tfsdk:"invitation_id"`
+	InvitationState  types.String `tfsdk:"invitation_state"`
+	Status           types.String `tfsdk:"status"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
 }
 
+const (
+	organizationMembershipProvisioningDirect = "direct"
+	organizationMembershipProvisioningInvite = "invite"
+)
+
 func (r *OrganizationMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_organization_membership"
 }
 
 func (r *OrganizationMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     organizationMembershipSchemaVersion,
 		Description: "Manages a WorkOS Organization Membership.",
 		MarkdownDescription: `
 Manages a WorkOS Organization Membership.
@@ -70,6 +86,22 @@ resource "workos_organization_membership" "example" {
 }
 ` + "```" + `
 
+### Membership by Invitation
+
+With ` + "`provisioning_mode = \"invite\"`" + `, the user is sent an invitation to the
+organization instead of being added directly, for organizations where members
+must accept before joining. The membership is tracked once the invitation is
+accepted.
+
+` + "```hcl" + `
+resource "workos_organization_membership" "invited" {
+  user_id           = workos_user.example.id
+  organization_id   = workos_organization.example.id
+  role_slug         = "member"
+  provisioning_mode = "invite"
+}
+` + "```" + `
+
 ### Membership with Role
 
 ` + "```hcl" + `
@@ -91,7 +123,7 @@ terraform import workos_organization_membership.example om_01HXYZ...
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description:         "The unique identifier of the organization membership.",
-				MarkdownDescription: "The unique identifier of the organization membership (e.g., `om_01HXYZ...`).",
+				MarkdownDescription: "The unique identifier of the organization membership (e.g., `om_01HXYZ...`). The invitation ID until an invitation sent with `provisioning_mode = \"invite\"` is accepted.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -125,9 +157,38 @@ terraform import workos_organization_membership.example om_01HXYZ...
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"provisioning_mode": schema.StringAttribute{
+				Description:         "How the user is added to the organization: direct or invite.",
+				MarkdownDescription: "How the user is added to the organization. `direct` creates the membership immediately. `invite` sends the user an invitation to the organization instead; the membership is tracked once the invitation is accepted, and an invitation that expires or is revoked is removed from state so the next apply sends a new one. `invite` cannot be combined with `role_slugs`. Changing this forces a new resource. Defaults to `direct`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(organizationMembershipProvisioningDirect),
+				Validators: []validator.String{
+					stringvalidator.OneOf(organizationMembershipProvisioningDirect, organizationMembershipProvisioningInvite),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"invitation_id": schema.StringAttribute{
+				Description:         "The ID of the invitation sent when provisioning_mode is invite.",
+				MarkdownDescription: "The ID of the invitation sent when `provisioning_mode` is `invite`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invitation_state": schema.StringAttribute{
+				Description:         "The state of the invitation sent when provisioning_mode is invite.",
+				MarkdownDescription: "The state of the invitation sent when `provisioning_mode` is `invite` (`pending` or `accepted`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"status": schema.StringAttribute{
 				Description:         "The status of the membership.",
-				MarkdownDescription: "The status of the membership (`active`, `inactive`, `pending`).",
+				MarkdownDescription: "The status of the membership (`active`, `inactive`, `pending`). `pending` while an invitation sent with `provisioning_mode = \"invite\"` has not been accepted.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -167,6 +228,25 @@ func (r *OrganizationMembershipResource) ConfigValidators(ctx context.Context) [
 	}
 }
 
+func (r *OrganizationMembershipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var provisioningMode types.String
+	var roleSlugs types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("provisioning_mode"), &provisioningMode)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role_slugs"), &roleSlugs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if provisioningMode.ValueString() == organizationMembershipProvisioningInvite && !roleSlugs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_slugs"),
+			"Invalid Provisioning Mode Configuration",
+			"role_slugs cannot be set when provisioning_mode is \"invite\"; invitations assign a single role_slug.",
+		)
+	}
+}
+
 func (r *OrganizationMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -185,6 +265,8 @@ func (r *OrganizationMembershipResource) Configure(ctx context.Context, req reso
 }
 
 func (r *OrganizationMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan OrganizationMembershipResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -192,6 +274,11 @@ func (r *OrganizationMembershipResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	if plan.ProvisioningMode.ValueString() == organizationMembershipProvisioningInvite {
+		r.createInvitation(ctx, &plan, resp)
+		return
+	}
+
 	tflog.Debug(ctx, "Creating organization membership", map[string]any{
 		"user_id":         plan.UserID.ValueString(),
 		"organization_id": plan.OrganizationID.ValueString(),
@@ -233,6 +320,8 @@ func (r *OrganizationMembershipResource) Create(ctx context.Context, req resourc
 		plan.RoleSlug = types.StringNull()
 	}
 	preserveOrganizationMembershipRoleSlugs(ctx, &plan, roleSlugs)
+	plan.InvitationID = types.StringNull()
+	plan.InvitationState = types.StringNull()
 	plan.Status = types.StringValue(membership.Status)
 	plan.CreatedAt = types.StringValue(membership.CreatedAt.Format(time.RFC3339))
 	plan.UpdatedAt = types.StringValue(membership.UpdatedAt.Format(time.RFC3339))
@@ -247,6 +336,8 @@ func (r *OrganizationMembershipResource) Create(ctx context.Context, req resourc
 }
 
 func (r *OrganizationMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state OrganizationMembershipResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -258,24 +349,42 @@ func (r *OrganizationMembershipResource) Read(ctx context.Context, req resource.
 		"id": state.ID.ValueString(),
 	})
 
-	membership, err := r.client.GetOrganizationMembership(ctx, state.ID.ValueString())
-	if err != nil {
-		if client.IsNotFound(err) {
-			tflog.Info(ctx, "Organization membership not found, removing from state", map[string]any{
-				"id": state.ID.ValueString(),
-			})
-			resp.State.RemoveResource(ctx)
-			return
+	if state.ProvisioningMode.IsNull() {
+		// Imported memberships are direct unless an invitation ID was imported.
+		state.ProvisioningMode = types.StringValue(organizationMembershipProvisioningDirect)
+		if isInvitationID(state.ID.ValueString()) {
+			state.ProvisioningMode = types.StringValue(organizationMembershipProvisioningInvite)
 		}
+	}
 
-		resp.Diagnostics.AddError(
-			"Error Reading Organization Membership",
-			"Could not read organization membership ID "+state.ID.ValueString()+": "+err.Error(),
-		)
-		return
+	var membership *client.OrganizationMembership
+	if isInvitationID(state.ID.ValueString()) {
+		membership = r.readInvitation(ctx, &state, resp)
+		if membership == nil {
+			return
+		}
+	} else {
+		var err error
+		membership, err = r.client.GetOrganizationMembershipInOrganization(ctx, state.OrganizationID.ValueString(), state.ID.ValueString())
+		if err != nil {
+			if isGoneOnRead(r.client, err, &resp.Diagnostics) {
+				tflog.Info(ctx, "Organization membership not found, removing from state", map[string]any{
+					"id": state.ID.ValueString(),
+				})
+				resp.State.RemoveResource(ctx)
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Reading Organization Membership",
+				"Could not read organization membership ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
 	}
 
 	// Map response to state
+	state.ID = types.StringValue(membership.ID)
 	state.UserID = types.StringValue(membership.UserID)
 	state.OrganizationID = types.StringValue(membership.OrganizationID)
 	if membership.Role.Slug != "" {
@@ -292,6 +401,8 @@ func (r *OrganizationMembershipResource) Read(ctx context.Context, req resource.
 }
 
 func (r *OrganizationMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan OrganizationMembershipResourceModel
 	var state OrganizationMembershipResourceModel
 
@@ -330,6 +441,8 @@ func (r *OrganizationMembershipResource) Update(ctx context.Context, req resourc
 		plan.OrganizationID = state.OrganizationID
 		plan.RoleSlug = state.RoleSlug
 		plan.RoleSlugs = state.RoleSlugs
+		plan.InvitationID = state.InvitationID
+		plan.InvitationState = state.InvitationState
 		plan.Status = state.Status
 		plan.CreatedAt = state.CreatedAt
 		plan.UpdatedAt = state.UpdatedAt
@@ -356,6 +469,8 @@ func (r *OrganizationMembershipResource) Update(ctx context.Context, req resourc
 		plan.RoleSlug = types.StringNull()
 	}
 	preserveOrganizationMembershipRoleSlugs(ctx, &plan, planRoleSlugs)
+	plan.InvitationID = state.InvitationID
+	plan.InvitationState = state.InvitationState
 	plan.Status = types.StringValue(membership.Status)
 	plan.CreatedAt = state.CreatedAt
 	plan.UpdatedAt = types.StringValue(membership.UpdatedAt.Format(time.RFC3339))
@@ -364,6 +479,8 @@ func (r *OrganizationMembershipResource) Update(ctx context.Context, req resourc
 }
 
 func (r *OrganizationMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state OrganizationMembershipResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -375,6 +492,21 @@ func (r *OrganizationMembershipResource) Delete(ctx context.Context, req resourc
 		"id": state.ID.ValueString(),
 	})
 
+	if isInvitationID(state.ID.ValueString()) {
+		if _, err := r.client.RevokeInvitation(ctx, state.ID.ValueString()); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Revoking Invitation",
+				"Could not revoke invitation "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Revoked organization invitation", map[string]any{
+			"id": state.ID.ValueString(),
+		})
+		return
+	}
+
 	err := r.client.DeleteOrganizationMembership(ctx, state.ID.ValueString())
 	if err != nil {
 		if client.IsNotFound(err) {
@@ -396,6 +528,30 @@ func (r *OrganizationMembershipResource) Delete(ctx context.Context, req resourc
 	})
 }
 
+func (r *OrganizationMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateReferences(ctx, r.client, req, resp, "organization_id", "user_id")
+	validateRoleReferences(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var id types.String
+	var planRoleSlug, stateRoleSlug types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("role_slug"), &planRoleSlug)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("role_slug"), &stateRoleSlug)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The role of a pending invitation cannot be changed, so a new invitation
+	// is sent instead.
+	if isInvitationID(id.ValueString()) && !planRoleSlug.IsUnknown() && !planRoleSlug.Equal(stateRoleSlug) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("role_slug"))
+	}
+}
+
 func (r *OrganizationMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Importing organization membership", map[string]any{
 		"id": req.ID,
@@ -404,6 +560,123 @@ func (r *OrganizationMembershipResource) ImportState(ctx context.Context, req re
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// createInvitation invites the user to the organization by email instead of
+// creating the membership. The invitation stands in for the membership until
+// it is accepted.
+func (r *OrganizationMembershipResource) createInvitation(ctx context.Context, plan *OrganizationMembershipResourceModel, resp *resource.CreateResponse) {
+	user, err := r.client.GetUser(ctx, plan.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Inviting User",
+			"Could not read user "+plan.UserID.ValueString()+" to invite: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Inviting user to organization", map[string]any{
+		"user_id":         plan.UserID.ValueString(),
+		"organization_id": plan.OrganizationID.ValueString(),
+	})
+
+	createReq := &client.InvitationCreateRequest{
+		Email:          user.Email,
+		OrganizationID: plan.OrganizationID.ValueString(),
+	}
+	if !plan.RoleSlug.IsNull() && !plan.RoleSlug.IsUnknown() {
+		createReq.RoleSlug = plan.RoleSlug.ValueString()
+	} else {
+		plan.RoleSlug = types.StringNull()
+	}
+
+	invitation, err := r.client.SendInvitation(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Inviting User",
+			"Could not send invitation to "+user.Email+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(invitation.ID)
+	plan.InvitationID = types.StringValue(invitation.ID)
+	plan.InvitationState = types.StringValue(invitation.State)
+	plan.Status = types.StringValue("pending")
+	plan.CreatedAt = types.StringValue(invitation.CreatedAt.Format(time.RFC3339))
+	plan.UpdatedAt = types.StringValue(invitation.UpdatedAt.Format(time.RFC3339))
+
+	tflog.Info(ctx, "Invited user to organization", map[string]any{
+		"id":              invitation.ID,
+		"user_id":         plan.UserID.ValueString(),
+		"organization_id": plan.OrganizationID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// readInvitation refreshes a membership that is still tracked by its
+// invitation. It returns the membership once the invitation is accepted;
+// otherwise it saves or removes the state itself and returns nil.
+func (r *OrganizationMembershipResource) readInvitation(ctx context.Context, state *OrganizationMembershipResourceModel, resp *resource.ReadResponse) *client.OrganizationMembership {
+	invitation, err := r.client.GetInvitation(ctx, state.ID.ValueString())
+	if err != nil {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
+			tflog.Info(ctx, "Invitation not found, removing from state", map[string]any{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return nil
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Invitation",
+			"Could not read invitation ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return nil
+	}
+
+	state.InvitationID = types.StringValue(invitation.ID)
+	state.InvitationState = types.StringValue(invitation.State)
+
+	switch invitation.State {
+	case "pending":
+		state.Status = types.StringValue("pending")
+		state.UpdatedAt = types.StringValue(invitation.UpdatedAt.Format(time.RFC3339))
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return nil
+	case "accepted":
+		memberships, err := r.client.ListOrganizationMemberships(ctx, state.UserID.ValueString(), state.OrganizationID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Organization Membership",
+				"Could not find the membership created by invitation "+invitation.ID+": "+err.Error(),
+			)
+			return nil
+		}
+		// A user can hold inactive memberships in the same organization
+		// alongside the one the invitation created, which is active.
+		for i := range memberships.Data {
+			if memberships.Data[i].Status == "active" {
+				return &memberships.Data[i]
+			}
+		}
+	}
+
+	// Expired and revoked invitations never become a membership, and the
+	// membership of an accepted one was removed: send a new invitation.
+	tflog.Info(ctx, "Invitation will not become a membership, removing from state", map[string]any{
+		"id":    invitation.ID,
+		"state": invitation.State,
+	})
+	resp.State.RemoveResource(ctx)
+	return nil
+}
+
+// isInvitationID reports whether a membership is still tracked by the
+// invitation sent for it.
+func isInvitationID(id string) bool {
+	return strings.HasPrefix(id, "invitation_")
+}
+
 func organizationMembershipRoleSlugs(ctx context.Context, value types.List) ([]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	if value.IsNull() || value.IsUnknown() {
@@ -427,3 +700,7 @@ func preserveOrganizationMembershipRoleSlugs(ctx context.Context, model *Organiz
 		model.RoleSlugs = types.ListNull(types.StringType)
 	}
 }
+
+func (r *OrganizationMembershipResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
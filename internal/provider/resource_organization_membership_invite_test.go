@@ -0,0 +1,227 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func invitedMembershipValues(id, invitationState string) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, id),
+		"user_id":           tftypes.NewValue(tftypes.String, "user_123"),
+		"organization_id":   tftypes.NewValue(tftypes.String, "org_123"),
+		"role_slug":         tftypes.NewValue(tftypes.String, "member"),
+		"provisioning_mode": tftypes.NewValue(tftypes.String, organizationMembershipProvisioningInvite),
+		"invitation_id":     tftypes.NewValue(tftypes.String, "invitation_123"),
+		"invitation_state":  tftypes.NewValue(tftypes.String, invitationState),
+		"status":            tftypes.NewValue(tftypes.String, "pending"),
+		"created_at":        tftypes.NewValue(tftypes.String, "2026-01-15T12:00:00Z"),
+		"updated_at":        tftypes.NewValue(tftypes.String, "2026-01-15T12:00:00Z"),
+	}
+}
+
+func readInvitedMembershipForTest(t *testing.T, handler http.HandlerFunc) (*resource.ReadResponse, OrganizationMembershipResourceModel) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &OrganizationMembershipResource{client: c}
+
+	state := testResourceState(t, r, invitedMembershipValues("invitation_123", "pending"))
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	var result OrganizationMembershipResourceModel
+	if !resp.Diagnostics.HasError() && !resp.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	}
+	return resp, result
+}
+
+func TestOrganizationMembershipResourceCreateSendsInvitation(t *testing.T) {
+	var requests []string
+	var invitationBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /user_management/users/user_123":
+			_, _ = w.Write([]byte(`{"id":"user_123","email":"jane@example.com"}`))
+		case "POST /user_management/invitations":
+			if err := json.NewDecoder(r.Body).Decode(&invitationBody); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"id":"invitation_123","email":"jane@example.com","state":"pending","organization_id":"org_123","expires_at":"2026-01-22T12:00:00.000Z","created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &OrganizationMembershipResource{client: c}
+
+	plan := testResourceState(t, r, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"user_id":           tftypes.NewValue(tftypes.String, "user_123"),
+		"organization_id":   tftypes.NewValue(tftypes.String, "org_123"),
+		"role_slug":         tftypes.NewValue(tftypes.String, "member"),
+		"provisioning_mode": tftypes.NewValue(tftypes.String, organizationMembershipProvisioningInvite),
+		"invitation_id":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"invitation_state":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"status":            tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"created_at":        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"updated_at":        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+	}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	expected := []string{
+		"GET /user_management/users/user_123",
+		"POST /user_management/invitations",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	if invitationBody["email"] != "jane@example.com" || invitationBody["organization_id"] != "org_123" || invitationBody["role_slug"] != "member" {
+		t.Fatalf("unexpected invitation body: %#v", invitationBody)
+	}
+
+	var state OrganizationMembershipResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.ID.ValueString() != "invitation_123" || state.InvitationState.ValueString() != "pending" || state.Status.ValueString() != "pending" {
+		t.Fatalf("unexpected state: %#v", state)
+	}
+}
+
+func TestOrganizationMembershipResourceReadPendingInvitation(t *testing.T) {
+	resp, state := readInvitedMembershipForTest(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/user_management/invitations/invitation_123" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"invitation_123","state":"pending","updated_at":"2026-01-15T12:00:00.000Z"}`))
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if state.ID.ValueString() != "invitation_123" || state.Status.ValueString() != "pending" {
+		t.Fatalf("unexpected state: %#v", state)
+	}
+}
+
+func TestOrganizationMembershipResourceReadAcceptedInvitation(t *testing.T) {
+	resp, state := readInvitedMembershipForTest(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /user_management/invitations/invitation_123":
+			_, _ = w.Write([]byte(`{"id":"invitation_123","state":"accepted"}`))
+		case "GET /user_management/organization_memberships":
+			if r.URL.Query().Get("user_id") != "user_123" || r.URL.Query().Get("organization_id") != "org_123" {
+				t.Fatalf("unexpected membership filters: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"data":[{"id":"om_123","user_id":"user_123","organization_id":"org_123","role":{"slug":"member"},"status":"active","created_at":"2026-01-16T12:00:00.000Z","updated_at":"2026-01-16T12:00:00.000Z"}],"list_metadata":{}}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if state.ID.ValueString() != "om_123" || state.Status.ValueString() != "active" {
+		t.Fatalf("expected the accepted membership, got %#v", state)
+	}
+	if state.InvitationID.ValueString() != "invitation_123" || state.InvitationState.ValueString() != "accepted" {
+		t.Fatalf("expected the invitation to be kept, got %#v", state)
+	}
+}
+
+func TestOrganizationMembershipResourceReadAcceptedInvitationSkipsInactiveMemberships(t *testing.T) {
+	resp, state := readInvitedMembershipForTest(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /user_management/invitations/invitation_123":
+			_, _ = w.Write([]byte(`{"id":"invitation_123","state":"accepted"}`))
+		case "GET /user_management/organization_memberships":
+			_, _ = w.Write([]byte(`{"data":[
+  {"id":"om_inactive","user_id":"user_123","organization_id":"org_123","role":{"slug":"admin"},"status":"inactive","created_at":"2025-06-01T12:00:00.000Z","updated_at":"2025-07-01T12:00:00.000Z"},
+  {"id":"om_pending","user_id":"user_123","organization_id":"org_123","role":{"slug":"member"},"status":"pending","created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"},
+  {"id":"om_123","user_id":"user_123","organization_id":"org_123","role":{"slug":"member"},"status":"active","created_at":"2026-01-16T12:00:00.000Z","updated_at":"2026-01-16T12:00:00.000Z"}
+],"list_metadata":{}}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if state.ID.ValueString() != "om_123" || state.Status.ValueString() != "active" {
+		t.Fatalf("expected the active membership, got %#v", state)
+	}
+}
+
+func TestOrganizationMembershipResourceReadAcceptedInvitationWithoutActiveMembership(t *testing.T) {
+	resp, _ := readInvitedMembershipForTest(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /user_management/invitations/invitation_123":
+			_, _ = w.Write([]byte(`{"id":"invitation_123","state":"accepted"}`))
+		case "GET /user_management/organization_memberships":
+			_, _ = w.Write([]byte(`{"data":[{"id":"om_inactive","user_id":"user_123","organization_id":"org_123","role":{"slug":"member"},"status":"inactive"}],"list_metadata":{}}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected the membership to be removed from state when only inactive memberships remain")
+	}
+}
+
+func TestOrganizationMembershipResourceReadExpiredInvitation(t *testing.T) {
+	for _, invitationState := range []string{"expired", "revoked"} {
+		t.Run(invitationState, func(t *testing.T) {
+			resp, _ := readInvitedMembershipForTest(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":"invitation_123","state":"` + invitationState + `"}`))
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Fatal("expected the membership to be removed from state")
+			}
+		})
+	}
+}
@@ -7,17 +7,17 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccOrganizationMembershipResource_basic(t *testing.T) {
-	rName := acctest.RandomWithPrefix("tfacc")
+	rName := testAccRandomName(t)
 	resourceName := "workos_organization_membership.test"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -42,12 +42,13 @@ func TestAccOrganizationMembershipResource_basic(t *testing.T) {
 }
 
 func TestAccOrganizationMembershipResource_withRole(t *testing.T) {
-	rName := acctest.RandomWithPrefix("tfacc")
+	rName := testAccRandomName(t)
 	resourceName := "workos_organization_membership.test"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccOrganizationMembershipResourceConfig_withRole(rName),
@@ -23,6 +23,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &OrganizationRoleResource{}
 var _ resource.ResourceWithImportState = &OrganizationRoleResource{}
+var _ resource.ResourceWithUpgradeState = &OrganizationRoleResource{}
 
 func NewOrganizationRoleResource() resource.Resource {
 	return &OrganizationRoleResource{}
@@ -53,6 +54,7 @@ func (r *OrganizationRoleResource) Metadata(ctx context.Context, req resource.Me
 
 func (r *OrganizationRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     organizationRoleSchemaVersion,
 		Description: "Manages a WorkOS Organization Role.",
 		MarkdownDescription: `
 Manages a WorkOS Organization Role.
@@ -188,6 +190,8 @@ func (r *OrganizationRoleResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *OrganizationRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan OrganizationRoleResourceModel
 
 	// Read Terraform plan data into the model
@@ -261,6 +265,8 @@ func (r *OrganizationRoleResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *OrganizationRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state OrganizationRoleResourceModel
 
 	// Read Terraform prior state data into the model
@@ -278,7 +284,7 @@ func (r *OrganizationRoleResource) Read(ctx context.Context, req resource.ReadRe
 	// Get the organization role from API
 	role, err := r.client.GetOrganizationRole(ctx, state.OrganizationID.ValueString(), state.Slug.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			tflog.Info(ctx, "Organization role not found, removing from state", map[string]any{
 				"organization_id": state.OrganizationID.ValueString(),
 				"slug":            state.Slug.ValueString(),
@@ -325,6 +331,8 @@ func (r *OrganizationRoleResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *OrganizationRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan OrganizationRoleResourceModel
 	var state OrganizationRoleResourceModel
 
@@ -401,6 +409,8 @@ func (r *OrganizationRoleResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *OrganizationRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state OrganizationRoleResourceModel
 
 	// Read Terraform prior state data into the model
@@ -458,3 +468,7 @@ func (r *OrganizationRoleResource) ImportState(ctx context.Context, req resource
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), parts[1])...)
 }
+
+func (r *OrganizationRoleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
@@ -21,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &OrganizationRolePermissionResource{}
 var _ resource.ResourceWithImportState = &OrganizationRolePermissionResource{}
+var _ resource.ResourceWithUpgradeState = &OrganizationRolePermissionResource{}
 
 func NewOrganizationRolePermissionResource() resource.Resource {
 	return &OrganizationRolePermissionResource{}
@@ -45,6 +46,7 @@ func (r *OrganizationRolePermissionResource) Metadata(ctx context.Context, req r
 
 func (r *OrganizationRolePermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     organizationRolePermissionSchemaVersion,
 		Description: "Assigns a permission to a WorkOS Organization Role.",
 		MarkdownDescription: `
 Assigns a permission to a WorkOS Organization Role.
@@ -126,6 +128,8 @@ func (r *OrganizationRolePermissionResource) Configure(ctx context.Context, req
 }
 
 func (r *OrganizationRolePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan OrganizationRolePermissionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -165,6 +169,8 @@ func (r *OrganizationRolePermissionResource) Create(ctx context.Context, req res
 }
 
 func (r *OrganizationRolePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state OrganizationRolePermissionResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -186,7 +192,7 @@ func (r *OrganizationRolePermissionResource) Read(ctx context.Context, req resou
 	// Get the role and check if the permission is present
 	role, err := r.client.GetOrganizationRole(ctx, orgID, roleSlug)
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			tflog.Info(ctx, "Organization role not found, removing permission assignment from state", map[string]any{
 				"organization_id": orgID,
 				"role_slug":       roleSlug,
@@ -233,6 +239,8 @@ func (r *OrganizationRolePermissionResource) Update(ctx context.Context, req res
 }
 
 func (r *OrganizationRolePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state OrganizationRolePermissionResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -296,3 +304,7 @@ func (r *OrganizationRolePermissionResource) ImportState(ctx context.Context, re
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission"), parts[2])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
+
+func (r *OrganizationRolePermissionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
@@ -6,20 +6,20 @@ package provider
 import (
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccOrganizationRolePermissionResource_Basic(t *testing.T) {
-	orgName := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
-	roleSlug := fmt.Sprintf("org-test-role-%d", time.Now().UnixNano())
-	permSlug := fmt.Sprintf("tf-acc-perm-%d", time.Now().UnixNano())
+	orgName := testAccRandomName(t)
+	roleSlug := "org-test-role-" + testAccRandomName(t)
+	permSlug := "tf-acc-perm-" + testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
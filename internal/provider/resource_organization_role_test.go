@@ -6,19 +6,19 @@ package provider
 import (
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccOrganizationRoleResource_Basic(t *testing.T) {
-	orgName := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
-	slug := fmt.Sprintf("org-test-role-%d", time.Now().UnixNano())
+	orgName := testAccRandomName(t)
+	slug := "org-test-role-" + testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -61,12 +61,13 @@ func TestAccOrganizationRoleResource_Basic(t *testing.T) {
 }
 
 func TestAccOrganizationRoleResource_NoDescription(t *testing.T) {
-	orgName := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
-	slug := fmt.Sprintf("org-test-role-%d", time.Now().UnixNano())
+	orgName := testAccRandomName(t)
+	slug := "org-test-role-" + testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			// Create without description
 			{
@@ -82,12 +83,13 @@ func TestAccOrganizationRoleResource_NoDescription(t *testing.T) {
 }
 
 func TestAccOrganizationRoleResource_Concurrent(t *testing.T) {
-	orgName := fmt.Sprintf("tf-acc-test-concurrent-%d", time.Now().UnixNano())
-	slugPrefix := fmt.Sprintf("org-tf-concurrent-%x", time.Now().UnixNano())
+	orgName := "tf-acc-test-concurrent-" + testAccRandomName(t)
+	slugPrefix := "org-tf-concurrent-" + testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccOrganizationRoleResourceConcurrentConfig(orgName, slugPrefix),
@@ -0,0 +1,72 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func TestOrganizationResourceUpdateClearsRemovedStripeCustomerID(t *testing.T) {
+	var updateBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /organizations/org_123":
+			if err := json.NewDecoder(r.Body).Decode(&updateBody); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"id":"org_123","name":"Acme","updated_at":"2026-01-16T12:00:00.000Z"}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &OrganizationResource{client: c}
+
+	state := testResourceState(t, r, map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, "org_123"),
+		"name":               tftypes.NewValue(tftypes.String, "Acme"),
+		"stripe_customer_id": tftypes.NewValue(tftypes.String, "cus_123"),
+		"created_at":         tftypes.NewValue(tftypes.String, "2026-01-15T12:00:00Z"),
+	})
+	plan := testResourceState(t, r, map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, "org_123"),
+		"name":       tftypes.NewValue(tftypes.String, "Acme"),
+		"created_at": tftypes.NewValue(tftypes.String, "2026-01-15T12:00:00Z"),
+	})
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State: state,
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if value, ok := updateBody["stripe_customer_id"]; !ok || value != "" {
+		t.Fatalf("expected stripe_customer_id to be cleared, got %#v", updateBody)
+	}
+
+	var result OrganizationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	if !result.StripeCustomerID.IsNull() {
+		t.Fatalf("expected null stripe_customer_id, got %s", result.StripeCustomerID)
+	}
+}
@@ -6,17 +6,17 @@ package provider
 import (
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccOrganizationResource_Basic(t *testing.T) {
-	name := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
+	name := testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -46,12 +46,13 @@ func TestAccOrganizationResource_Basic(t *testing.T) {
 }
 
 func TestAccOrganizationResource_WithDomains(t *testing.T) {
-	name := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
-	domain := fmt.Sprintf("test-%d.example.com", time.Now().UnixNano())
+	name := testAccRandomName(t)
+	domain := "test-" + testAccRandomName(t) + ".example.com"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			// Create with domains
 			{
@@ -72,12 +73,13 @@ func TestAccOrganizationResource_WithDomains(t *testing.T) {
 }
 
 func TestAccOrganizationResource_WithMetadataAndExternalID(t *testing.T) {
-	name := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
-	externalID := fmt.Sprintf("ext-%d", time.Now().UnixNano())
+	name := testAccRandomName(t)
+	externalID := "ext-" + testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			// Create with external_id and metadata
 			{
@@ -107,6 +109,36 @@ func TestAccOrganizationResource_WithMetadataAndExternalID(t *testing.T) {
 	})
 }
 
+func TestAccOrganizationResource_CascadeDelete(t *testing.T) {
+	name := testAccRandomName(t)
+	email := "tf-acc-test-" + testAccRandomName(t) + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
+		Steps: []resource.TestStep{
+			// Create an organization with a member; destroy cascades to the membership
+			{
+				Config: testAccOrganizationResourceConfigWithCascadeDelete(name, email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("workos_organization.test", "name", name),
+					resource.TestCheckResourceAttr("workos_organization.test", "cascade_delete", "true"),
+					resource.TestCheckResourceAttr("workos_organization.test", "cascade_delete_integrations", "false"),
+					resource.TestCheckResourceAttrSet("workos_organization_membership.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "workos_organization.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cascade_delete"},
+			},
+		},
+	})
+}
+
 func testAccOrganizationResourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "workos_organization" "test" {
@@ -135,3 +167,21 @@ resource "workos_organization" "test" {
 }
 `, name, externalID, metadataKey, metadataValue)
 }
+
+func testAccOrganizationResourceConfigWithCascadeDelete(name, email string) string {
+	return fmt.Sprintf(`
+resource "workos_organization" "test" {
+  name           = %[1]q
+  cascade_delete = true
+}
+
+resource "workos_user" "test" {
+  email = %[2]q
+}
+
+resource "workos_organization_membership" "test" {
+  user_id         = workos_user.test.id
+  organization_id = workos_organization.test.id
+}
+`, name, email)
+}
@@ -23,6 +23,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PermissionResource{}
 var _ resource.ResourceWithImportState = &PermissionResource{}
+var _ resource.ResourceWithUpgradeState = &PermissionResource{}
 
 func NewPermissionResource() resource.Resource {
 	return &PermissionResource{}
@@ -51,6 +52,7 @@ func (r *PermissionResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *PermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     permissionSchemaVersion,
 		Description: "Manages a WorkOS Permission.",
 		MarkdownDescription: `
 Manages a WorkOS Permission.
@@ -165,6 +167,8 @@ func (r *PermissionResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan PermissionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -217,6 +221,8 @@ func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state PermissionResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -231,7 +237,7 @@ func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	perm, err := r.client.GetPermission(ctx, state.Slug.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			tflog.Info(ctx, "Permission not found, removing from state", map[string]any{
 				"slug": state.Slug.ValueString(),
 			})
@@ -259,6 +265,8 @@ func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan PermissionResourceModel
 	var state PermissionResourceModel
 
@@ -316,6 +324,8 @@ func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state PermissionResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -356,3 +366,7 @@ func (r *PermissionResource) ImportState(ctx context.Context, req resource.Impor
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), req.ID)...)
 }
+
+func (r *PermissionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
@@ -6,17 +6,17 @@ package provider
 import (
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccPermissionResource_Basic(t *testing.T) {
-	slug := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
+	slug := testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -51,11 +51,12 @@ func TestAccPermissionResource_Basic(t *testing.T) {
 }
 
 func TestAccPermissionResource_NoDescription(t *testing.T) {
-	slug := fmt.Sprintf("tf-acc-test-%d", time.Now().UnixNano())
+	slug := testAccRandomName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			// Create without description
 			{
@@ -0,0 +1,251 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+var _ resource.Resource = &SessionRevocationResource{}
+var _ resource.ResourceWithConfigValidators = &SessionRevocationResource{}
+var _ resource.ResourceWithUpgradeState = &SessionRevocationResource{}
+
+func NewSessionRevocationResource() resource.Resource {
+	return &SessionRevocationResource{}
+}
+
+// SessionRevocationResource revokes every active session of a user or of an
+// organization's members when it is created. It has no remote object of its
+// own: destroying it does nothing, and changing triggers revokes again.
+type SessionRevocationResource struct {
+	client *client.Client
+}
+
+type SessionRevocationResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	UserID            types.String `tfsdk:"user_id"`
+	OrganizationID    types.String `tfsdk:"organization_id"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	RevokedSessionIDs types.List   `tfsdk:"revoked_session_ids"`
+	RevokedAt         types.String `tfsdk:"revoked_at"`
+}
+
+func (r *SessionRevocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_revocation"
+}
+
+func (r *SessionRevocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     sessionRevocationSchemaVersion,
+		Description: "Revokes all active AuthKit sessions for a user, or for every member of an organization, when created. Destroying the resource does not affect sessions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user or organization whose sessions were revoked.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Revoke every active session of this user. Exactly one of user_id or organization_id must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Revoke every active session scoped to this organization, across all of its members. Exactly one of user_id or organization_id must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that revoke sessions again when they change.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"revoked_session_ids": schema.ListAttribute{
+				Description: "The IDs of the sessions that were revoked.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"revoked_at": schema.StringAttribute{
+				Description: "The timestamp when the sessions were revoked.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SessionRevocationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_id"),
+			path.MatchRoot("organization_id"),
+		),
+	}
+}
+
+func (r *SessionRevocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *SessionRevocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var plan SessionRevocationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		revoked []string
+		err     error
+	)
+	if !plan.UserID.IsNull() {
+		plan.ID = plan.UserID
+		revoked, err = r.revokeSessions(ctx, plan.UserID.ValueString(), "")
+	} else {
+		plan.ID = plan.OrganizationID
+		revoked, err = r.revokeOrganizationSessions(ctx, plan.OrganizationID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Revoking Sessions", "Could not revoke sessions for "+plan.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Revoked sessions", map[string]any{
+		"id":    plan.ID.ValueString(),
+		"count": len(revoked),
+	})
+
+	revokedIDs, diags := types.ListValueFrom(ctx, types.StringType, revoked)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.RevokedSessionIDs = revokedIDs
+	plan.RevokedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SessionRevocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SessionRevocationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SessionRevocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SessionRevocationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SessionRevocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SessionRevocationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removing session revocation from state; sessions are not affected", map[string]any{"id": state.ID.ValueString()})
+}
+
+// revokeOrganizationSessions revokes the sessions scoped to an organization
+// for every user with a membership in it.
+func (r *SessionRevocationResource) revokeOrganizationSessions(ctx context.Context, organizationID string) ([]string, error) {
+	memberships, err := r.client.ListOrganizationMemberships(ctx, "", organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := []string{}
+	seen := map[string]bool{}
+	for _, membership := range memberships.Data {
+		if seen[membership.UserID] {
+			continue
+		}
+		seen[membership.UserID] = true
+
+		ids, err := r.revokeSessions(ctx, membership.UserID, organizationID)
+		if err != nil {
+			return revoked, err
+		}
+		revoked = append(revoked, ids...)
+	}
+
+	return revoked, nil
+}
+
+// revokeSessions revokes every active session of a user. When organizationID
+// is set only sessions scoped to that organization are revoked.
+func (r *SessionRevocationResource) revokeSessions(ctx context.Context, userID, organizationID string) ([]string, error) {
+	sessions, err := r.client.ListUserSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := []string{}
+	for _, session := range sessions.Data {
+		if session.Status != "" && session.Status != "active" {
+			continue
+		}
+		if organizationID != "" && session.OrganizationID != organizationID {
+			continue
+		}
+		if err := r.client.RevokeSession(ctx, session.ID); err != nil {
+			if client.IsNotFound(err) {
+				continue
+			}
+			return revoked, err
+		}
+		revoked = append(revoked, session.ID)
+	}
+
+	return revoked, nil
+}
+
+func (r *SessionRevocationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// sessionRevocationServer serves two members of org_123, each with an active
+// session in org_123, a session in another organization and an ended session.
+type sessionRevocationServer struct {
+	t       *testing.T
+	revoked []string
+}
+
+func (s *sessionRevocationServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.Method + " " + r.URL.Path {
+	case "GET /user_management/organization_memberships":
+		_, _ = w.Write([]byte(`{"data":[{"id":"om_1","user_id":"user_1"},{"id":"om_2","user_id":"user_2"}],"list_metadata":{}}`))
+	case "GET /user_management/users/user_1/sessions", "GET /user_management/users/user_2/sessions":
+		user := r.URL.Path[len("/user_management/users/") : len(r.URL.Path)-len("/sessions")]
+		_, _ = fmt.Fprintf(w, `{"data":[
+  {"id":"session_%[1]s_org","user_id":"%[1]s","organization_id":"org_123","status":"active"},
+  {"id":"session_%[1]s_other","user_id":"%[1]s","organization_id":"org_other","status":"active"},
+  {"id":"session_%[1]s_ended","user_id":"%[1]s","organization_id":"org_123","status":"revoked"}
+],"list_metadata":{}}`, user)
+	case "POST /user_management/sessions/revoke":
+		var body client.SessionRevokeRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			s.t.Fatalf("failed to decode request body: %v", err)
+		}
+		s.revoked = append(s.revoked, body.SessionID)
+		_, _ = w.Write([]byte(`{}`))
+	default:
+		s.t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func newSessionRevocationTestResource(t *testing.T, fake *sessionRevocationServer) *SessionRevocationResource {
+	t.Helper()
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return &SessionRevocationResource{client: c}
+}
+
+func TestSessionRevocationRevokesUserSessions(t *testing.T) {
+	fake := &sessionRevocationServer{t: t}
+	r := newSessionRevocationTestResource(t, fake)
+
+	revoked, err := r.revokeSessions(context.Background(), "user_1", "")
+	if err != nil {
+		t.Fatalf("revokeSessions returned error: %v", err)
+	}
+
+	expected := []string{"session_user_1_org", "session_user_1_other"}
+	if fmt.Sprint(revoked) != fmt.Sprint(expected) || fmt.Sprint(fake.revoked) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v to be revoked, got %v (requests %v)", expected, revoked, fake.revoked)
+	}
+}
+
+func TestSessionRevocationRevokesOnlyOrganizationSessions(t *testing.T) {
+	fake := &sessionRevocationServer{t: t}
+	r := newSessionRevocationTestResource(t, fake)
+
+	revoked, err := r.revokeOrganizationSessions(context.Background(), "org_123")
+	if err != nil {
+		t.Fatalf("revokeOrganizationSessions returned error: %v", err)
+	}
+
+	expected := []string{"session_user_1_org", "session_user_2_org"}
+	if fmt.Sprint(revoked) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v to be revoked, got %v", expected, revoked)
+	}
+}
@@ -5,16 +5,21 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/osodevops/terraform-provider-workos/internal/client"
@@ -23,6 +28,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UserResource{}
 var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
+var _ resource.ResourceWithUpgradeState = &UserResource{}
 
 func NewUserResource() resource.Resource {
 	return &UserResource{}
@@ -35,20 +43,54 @@ type UserResource struct {
 
 // UserResourceModel describes the resource data model.
 type UserResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Email             types.String `tfsdk:"email"`
-	EmailVerified     types.Bool   `tfsdk:"email_verified"`
-	FirstName         types.String `tfsdk:"first_name"`
-	LastName          types.String `tfsdk:"last_name"`
-	Password          types.String `tfsdk:"password"`
-	PasswordHash      types.String `tfsdk:"password_hash"`
-	PasswordHashType  types.String `tfsdk:"password_hash_type"`
-	ExternalID        types.String `tfsdk:"external_id"`
-	Metadata          types.Map    `tfsdk:"metadata"`
-	Locale            types.String `tfsdk:"locale"`
-	ProfilePictureURL types.String `tfsdk:"profile_picture_url"`
-	CreatedAt         types.String `tfsdk:"created_at"`
-	UpdatedAt         types.String `tfsdk:"updated_at"`
+	ID                       types.String `tfsdk:"id"`
+	Email                    types.String `tfsdk:"email"`
+	EmailVerified            types.Bool   `tfsdk:"email_verified"`
+	FirstName                types.String `tfsdk:"first_name"`
+	LastName                 types.String `tfsdk:"last_name"`
+	Password                 types.String `tfsdk:"password"`
+	PasswordHash             types.String `tfsdk:"password_hash"`
+	PasswordHashType         types.String `tfsdk:"password_hash_type"`
+	ExternalID               types.String `tfsdk:"external_id"`
+	Metadata                 types.Map    `tfsdk:"metadata"`
+	MetadataAll              types.Map    `tfsdk:"metadata_all"`
+	Locale                   types.String `tfsdk:"locale"`
+	ProfilePictureURL        types.String `tfsdk:"profile_picture_url"`
+	CreatedAt                types.String `tfsdk:"created_at"`
+	UpdatedAt                types.String `tfsdk:"updated_at"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
+	VerifyEmailChange        types.Bool   `tfsdk:"verify_email_change"`
+	EmailVerificationPending types.Bool   `tfsdk:"email_verification_pending"`
+	DeletionBehavior         types.String `tfsdk:"deletion_behavior"`
+	EmailVerifiedPolicy      types.String `tfsdk:"email_verified_policy"`
+
+	OrganizationMembership []UserOrganizationMembershipModel `tfsdk:"organization_membership"`
+}
+
+const (
+	userDeletionBehaviorDelete    = "delete"
+	userDeletionBehaviorAnonymize = "anonymize"
+
+	userEmailVerifiedPolicyEnforce        = "enforce"
+	userEmailVerifiedPolicyIgnoreUpgrades = "ignore_upgrades"
+	userEmailVerifiedPolicyIgnore         = "ignore"
+)
+
+// userPasswordHashTypes are the algorithms WorkOS accepts imported password
+// hashes from.
+var userPasswordHashTypes = []string{"bcrypt", "firebase-scrypt", "ssha", "scrypt", "pbkdf2", "argon2"}
+
+// userUpdatedAtPlanModifier keeps updated_at from state unless an attribute
+// whose change makes WorkOS bump it has changed.
+var userUpdatedAtPlanModifier = useStateForUnknownIfConfigUnchanged{
+	configAttributes: []path.Path{
+		path.Root("email"),
+		path.Root("email_verified"),
+		path.Root("first_name"),
+		path.Root("last_name"),
+		path.Root("external_id"),
+		path.Root("metadata"),
+	},
 }
 
 func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -57,6 +99,7 @@ func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     userSchemaVersion,
 		Description: "Manages a WorkOS AuthKit User.",
 		MarkdownDescription: `
 Manages a WorkOS AuthKit User.
@@ -107,6 +150,20 @@ resource "workos_user" "with_password" {
 }
 ` + "```" + `
 
+### User Created into an Organization
+
+` + "```hcl" + `
+resource "workos_user" "tenant_admin" {
+  email          = "admin@acme.com"
+  email_verified = true
+
+  organization_membership {
+    organization_id = workos_organization.acme.id
+    role_slug       = "admin"
+  }
+}
+` + "```" + `
+
 ## Import
 
 Users can be imported using the user ID:
@@ -138,6 +195,39 @@ if password authentication is required.
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					emailVerifiedPlanModifier{},
+				},
+			},
+			"email_verified_policy": schema.StringAttribute{
+				Description:         "How email_verified is reconciled with WorkOS: enforce, ignore_upgrades, or ignore.",
+				MarkdownDescription: "How `email_verified` is reconciled with WorkOS. `enforce` sets it to the configured value. `ignore_upgrades` leaves a user WorkOS has marked verified (for example after they clicked the verification link) verified when the configuration says `false`, but still verifies unverified users configured as `true`. `ignore` only uses `email_verified` on create and tracks the WorkOS value afterwards. Defaults to `enforce`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(userEmailVerifiedPolicyEnforce),
+				Validators: []validator.String{
+					stringvalidator.OneOf(userEmailVerifiedPolicyEnforce, userEmailVerifiedPolicyIgnoreUpgrades, userEmailVerifiedPolicyIgnore),
+				},
+			},
+			"verify_email_change": schema.BoolAttribute{
+				Description:         "Whether email changes go through WorkOS email verification instead of overwriting the address as verified.",
+				MarkdownDescription: "Whether email changes go through WorkOS email verification. When `true`, changing `email` marks the new address unverified and sends a verification email to it; `email_verified` is then tracked from WorkOS and cannot be set in configuration. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"email_verification_pending": schema.BoolAttribute{
+				Description:         "Whether a verification email was sent for a changed email address that has not been verified yet.",
+				MarkdownDescription: "Whether a verification email was sent for a changed email address that has not been verified yet. Only set to `true` by email changes made with `verify_email_change`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					useStateForUnknownIfConfigUnchanged{
+						configAttributes: []path.Path{
+							path.Root("email"),
+							path.Root("verify_email_change"),
+						},
+					},
+				},
 			},
 			"first_name": schema.StringAttribute{
 				Description:         "The user's first name.",
@@ -159,8 +249,8 @@ if password authentication is required.
 				},
 			},
 			"password_hash": schema.StringAttribute{
-				Description:         "A pre-hashed password. Write-only, not returned by API.",
-				MarkdownDescription: "A pre-hashed password (bcrypt or argon2). This is a write-only field and is not returned by the API. Use this if you're migrating users with existing password hashes.",
+				Description:         "A pre-hashed password. Requires password_hash_type. Write-only, not returned by API.",
+				MarkdownDescription: "A pre-hashed password. Requires `password_hash_type`. This is a write-only field and is not returned by the API. Use this if you're migrating users with existing password hashes.",
 				Optional:            true,
 				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
@@ -168,9 +258,12 @@ if password authentication is required.
 				},
 			},
 			"password_hash_type": schema.StringAttribute{
-				Description:         "The type of password hash. Write-only, used with password_hash.",
-				MarkdownDescription: "The type of password hash (e.g., `bcrypt`, `argon2`). This is a write-only field used only during creation alongside `password_hash`.",
+				Description:         "The algorithm password_hash was created with: bcrypt, firebase-scrypt, ssha, scrypt, pbkdf2 or argon2. Write-only, used with password_hash.",
+				MarkdownDescription: "The algorithm `password_hash` was created with: `bcrypt`, `firebase-scrypt`, `ssha`, `scrypt`, `pbkdf2` or `argon2`. This is a write-only field used only during creation alongside `password_hash`.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(userPasswordHashTypes...),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -183,7 +276,7 @@ if password authentication is required.
 			},
 			"metadata": schema.MapAttribute{
 				Description:         "Custom metadata for the user.",
-				MarkdownDescription: "Custom metadata for the user as key-value string pairs.",
+				MarkdownDescription: "Custom metadata for the user as key-value string pairs. Keys inherited from the provider's `default_metadata` are not included.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
@@ -191,6 +284,12 @@ if password authentication is required.
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"metadata_all": schema.MapAttribute{
+				Description:         "The user's metadata including keys inherited from the provider's default_metadata.",
+				MarkdownDescription: "The user's metadata including keys inherited from the provider's `default_metadata`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"locale": schema.StringAttribute{
 				Description:         "The user's locale.",
 				MarkdownDescription: "The user's locale (e.g., `en-US`). Set by the system based on user activity.",
@@ -207,6 +306,23 @@ if password authentication is required.
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Description:         "Whether to adopt an existing user with the same email instead of failing on create.",
+				MarkdownDescription: "Whether to adopt an existing user with the same email instead of failing on create. The existing user is brought under management and updated to match the configuration. Adoption fails if `password` or `password_hash` is set. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deletion_behavior": schema.StringAttribute{
+				Description:         "What happens to the user on destroy: delete, or anonymize then delete.",
+				MarkdownDescription: "What happens to the user on destroy. `delete` deletes the user. `anonymize` first clears the user's first name, last name, and metadata, then deletes the user, for erasure procedures that require personal data to be scrubbed explicitly. Defaults to `delete`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(userDeletionBehaviorDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(userDeletionBehaviorDelete, userDeletionBehaviorAnonymize),
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Description:         "The timestamp when the user was created.",
 				MarkdownDescription: "The timestamp when the user was created (RFC3339 format).",
@@ -220,19 +336,55 @@ if password authentication is required.
 				MarkdownDescription: "The timestamp when the user was last updated (RFC3339 format).",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
-					useStateForUnknownIfConfigUnchanged{
-						configAttributes: []path.Path{
-							path.Root("email"),
-							path.Root("email_verified"),
-							path.Root("first_name"),
-							path.Root("last_name"),
-							path.Root("external_id"),
-							path.Root("metadata"),
-						},
-					},
+					userUpdatedAtPlanModifier,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"organization_membership": userOrganizationMembershipBlock(),
+		},
+	}
+}
+
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var verifyEmailChange, emailVerified types.Bool
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("verify_email_change"), &verifyEmailChange)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("email_verified"), &emailVerified)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if verifyEmailChange.ValueBool() && !emailVerified.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email_verified"),
+			"Invalid Email Verification Configuration",
+			"email_verified cannot be set when verify_email_change is true; WorkOS tracks verification of the address.",
+		)
+	}
+
+	var passwordHash, passwordHashType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_hash"), &passwordHash)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_hash_type"), &passwordHashType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// WorkOS cannot tell which algorithm produced an imported hash, so the
+	// two are only accepted together.
+	switch {
+	case !passwordHash.IsNull() && passwordHashType.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password_hash_type"),
+			"Missing Password Hash Type",
+			"password_hash_type must be set alongside password_hash, to one of: "+strings.Join(userPasswordHashTypes, ", ")+".",
+		)
+	case passwordHash.IsNull() && !passwordHashType.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password_hash"),
+			"Missing Password Hash",
+			"password_hash_type only applies to a password_hash; set password_hash or remove password_hash_type.",
+		)
 	}
 }
 
@@ -254,6 +406,8 @@ func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan UserResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -288,9 +442,9 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if !plan.ExternalID.IsNull() && !plan.ExternalID.IsUnknown() {
 		createReq.ExternalID = plan.ExternalID.ValueString()
 	}
-	if !plan.Metadata.IsNull() && !plan.Metadata.IsUnknown() {
+	if !plan.MetadataAll.IsNull() && !plan.MetadataAll.IsUnknown() {
 		metadata := make(map[string]string)
-		resp.Diagnostics.Append(plan.Metadata.ElementsAs(ctx, &metadata, false)...)
+		resp.Diagnostics.Append(plan.MetadataAll.ElementsAs(ctx, &metadata, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -298,6 +452,33 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	user, err := r.client.CreateUser(ctx, createReq)
+	adopted := false
+	if err != nil && plan.AdoptExisting.ValueBool() && isUserEmailConflict(err) {
+		// The existing user's password is left untouched, so adopting it while
+		// recording a configured password in state would misrepresent the user.
+		if !plan.Password.IsNull() || !plan.PasswordHash.IsNull() {
+			resp.Diagnostics.AddError(
+				"Error Adopting User",
+				"A user with email "+plan.Email.ValueString()+" already exists. Existing users cannot be adopted "+
+					"while password or password_hash is configured; remove them from the configuration and apply again.",
+			)
+			return
+		}
+
+		tflog.Info(ctx, "User email already exists, adopting existing user", map[string]any{
+			"email": plan.Email.ValueString(),
+		})
+
+		user, err = r.adoptExistingUser(ctx, &plan)
+		adopted = err == nil
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Adopting User",
+				"Could not adopt existing user with email "+plan.Email.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating User",
@@ -326,13 +507,12 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	} else {
 		plan.ExternalID = types.StringNull()
 	}
-	if len(user.Metadata) > 0 {
-		metadataMap, diags := types.MapValueFrom(ctx, types.StringType, user.Metadata)
-		resp.Diagnostics.Append(diags...)
-		plan.Metadata = metadataMap
-	} else {
-		plan.Metadata = types.MapNull(types.StringType)
-	}
+	metadata, diags := configuredMetadata(ctx, defaultMetadata(r.client), user.Metadata, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	plan.Metadata = metadata
+	metadataAll, diags := metadataValue(ctx, user.Metadata)
+	resp.Diagnostics.Append(diags...)
+	plan.MetadataAll = metadataAll
 	if user.Locale != "" {
 		plan.Locale = types.StringValue(user.Locale)
 	} else {
@@ -340,16 +520,46 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 	plan.CreatedAt = types.StringValue(user.CreatedAt.Format(time.RFC3339))
 	plan.UpdatedAt = types.StringValue(user.UpdatedAt.Format(time.RFC3339))
+	plan.EmailVerificationPending = types.BoolValue(false)
 
 	tflog.Info(ctx, "Created user", map[string]any{
 		"id":    user.ID,
 		"email": user.Email,
 	})
 
+	// A user that cannot be added to its organization is deleted again, so
+	// the next apply creates both instead of replacing a tainted user. An
+	// adopted user existed before this apply and is left alone.
+	if len(plan.OrganizationMembership) > 0 {
+		if err := r.createUserOrganizationMembership(ctx, user.ID, &plan.OrganizationMembership[0]); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("organization_membership"),
+				"Error Creating User Organization Membership",
+				fmt.Sprintf("Could not add user %s to organization %s: %s", user.ID, plan.OrganizationMembership[0].OrganizationID.ValueString(), err),
+			)
+			if adopted {
+				return
+			}
+
+			if err := r.client.DeleteUser(ctx, user.ID); err != nil && !client.IsNotFound(err) {
+				// The user is kept in state, tainted, so it is not orphaned.
+				resp.Diagnostics.AddError(
+					"Error Deleting User",
+					"Could not delete user "+user.ID+" after its organization membership failed: "+err.Error(),
+				)
+				plan.OrganizationMembership = nil
+				resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			}
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state UserResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -363,7 +573,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	user, err := r.client.GetUser(ctx, state.ID.ValueString())
 	if err != nil {
-		if client.IsNotFound(err) {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
 			tflog.Info(ctx, "User not found, removing from state", map[string]any{
 				"id": state.ID.ValueString(),
 			})
@@ -401,13 +611,12 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	} else {
 		state.ExternalID = types.StringNull()
 	}
-	if len(user.Metadata) > 0 {
-		metadataMap, diags := types.MapValueFrom(ctx, types.StringType, user.Metadata)
-		resp.Diagnostics.Append(diags...)
-		state.Metadata = metadataMap
-	} else {
-		state.Metadata = types.MapNull(types.StringType)
-	}
+	metadata, diags := configuredMetadata(ctx, defaultMetadata(r.client), user.Metadata, state.Metadata)
+	resp.Diagnostics.Append(diags...)
+	state.Metadata = metadata
+	metadataAll, diags := metadataValue(ctx, user.Metadata)
+	resp.Diagnostics.Append(diags...)
+	state.MetadataAll = metadataAll
 	if user.Locale != "" {
 		state.Locale = types.StringValue(user.Locale)
 	} else {
@@ -418,10 +627,100 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Note: Password, PasswordHash, and PasswordHashType are not returned by the API, preserve state values
 
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
+	if state.VerifyEmailChange.IsNull() {
+		state.VerifyEmailChange = types.BoolValue(false)
+	}
+	if state.DeletionBehavior.IsNull() {
+		state.DeletionBehavior = types.StringValue(userDeletionBehaviorDelete)
+	}
+	if state.EmailVerifiedPolicy.IsNull() {
+		state.EmailVerifiedPolicy = types.StringValue(userEmailVerifiedPolicyEnforce)
+	}
+	// A pending change is complete once WorkOS reports the address verified.
+	state.EmailVerificationPending = types.BoolValue(state.EmailVerificationPending.ValueBool() && !user.EmailVerified)
+	state.OrganizationMembership = r.readUserOrganizationMembership(ctx, state.OrganizationMembership, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// adoptExistingUser looks up the user that already owns the planned email and
+// updates it to match the plan.
+func (r *UserResource) adoptExistingUser(ctx context.Context, plan *UserResourceModel) (*client.User, error) {
+	existing, err := r.client.GetUserByEmail(ctx, plan.Email.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(existing.Email, plan.Email.ValueString()) {
+		return nil, fmt.Errorf("user lookup for %s returned %s", plan.Email.ValueString(), existing.Email)
+	}
+
+	emailVerified := plan.EmailVerified.ValueBool()
+	updateReq := &client.UserUpdateRequest{
+		EmailVerified: &emailVerified,
+	}
+	if !plan.FirstName.IsNull() {
+		updateReq.FirstName = plan.FirstName.ValueString()
+	}
+	if !plan.LastName.IsNull() {
+		updateReq.LastName = plan.LastName.ValueString()
+	}
+	if !plan.ExternalID.IsNull() && !plan.ExternalID.IsUnknown() {
+		updateReq.ExternalID = plan.ExternalID.ValueString()
+	}
+	if !plan.MetadataAll.IsUnknown() {
+		// Replace the existing metadata — keys not in the plan are sent as null.
+		updateMap := make(map[string]*string)
+		if !plan.MetadataAll.IsNull() {
+			newMetadata := make(map[string]string)
+			if diags := plan.MetadataAll.ElementsAs(ctx, &newMetadata, false); diags.HasError() {
+				return nil, fmt.Errorf("could not read planned metadata")
+			}
+			for k, v := range newMetadata {
+				v := v
+				updateMap[k] = &v
+			}
+		}
+		for key := range existing.Metadata {
+			if _, exists := updateMap[key]; !exists {
+				updateMap[key] = nil
+			}
+		}
+		if len(updateMap) > 0 {
+			updateReq.Metadata = updateMap
+		}
+	}
+
+	return r.client.UpdateUser(ctx, existing.ID, updateReq)
+}
+
+// isUserEmailConflict reports whether a create user error was caused by the
+// email address already being in use.
+func isUserEmailConflict(err error) bool {
+	if client.IsConflict(err) {
+		return true
+	}
+
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.Code == "email_not_available" {
+		return true
+	}
+	for _, ve := range apiErr.Errors {
+		if ve.Code == "email_not_available" {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var plan UserResourceModel
 	var state UserResourceModel
 
@@ -436,18 +735,24 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		"email": plan.Email.ValueString(),
 	})
 
+	r.updateUserOrganizationMembership(ctx, state.ID.ValueString(), plan.OrganizationMembership, state.OrganizationMembership, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Skip update if no user-configurable attributes changed
 	if plan.Email.Equal(state.Email) &&
 		plan.EmailVerified.Equal(state.EmailVerified) &&
 		plan.FirstName.Equal(state.FirstName) &&
 		plan.LastName.Equal(state.LastName) &&
 		plan.ExternalID.Equal(state.ExternalID) &&
-		plan.Metadata.Equal(state.Metadata) {
+		plan.MetadataAll.Equal(state.MetadataAll) {
 		plan.ID = state.ID
 		plan.CreatedAt = state.CreatedAt
 		plan.UpdatedAt = state.UpdatedAt
 		plan.ProfilePictureURL = state.ProfilePictureURL
 		plan.Locale = state.Locale
+		plan.EmailVerificationPending = state.EmailVerificationPending
 		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 		return
 	}
@@ -458,9 +763,14 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	emailVerified := plan.EmailVerified.ValueBool()
 	updateReq.EmailVerified = &emailVerified
 
-	if !plan.Email.Equal(state.Email) {
+	emailChanged := !plan.Email.Equal(state.Email)
+	verifyEmail := emailChanged && plan.VerifyEmailChange.ValueBool()
+	if emailChanged {
 		updateReq.Email = plan.Email.ValueString()
 	}
+	if verifyEmail {
+		emailVerified = false
+	}
 	if !plan.FirstName.Equal(state.FirstName) {
 		updateReq.FirstName = plan.FirstName.ValueString()
 	}
@@ -470,33 +780,13 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !plan.ExternalID.Equal(state.ExternalID) {
 		updateReq.ExternalID = plan.ExternalID.ValueString()
 	}
-	if !plan.Metadata.Equal(state.Metadata) && !plan.Metadata.IsUnknown() {
-		// WorkOS merges metadata on update — removed keys must be sent as null.
-		updateMap := make(map[string]*string)
-		if !plan.Metadata.IsNull() {
-			newMetadata := make(map[string]string)
-			resp.Diagnostics.Append(plan.Metadata.ElementsAs(ctx, &newMetadata, false)...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			for k, v := range newMetadata {
-				v := v
-				updateMap[k] = &v
-			}
-		}
-		if !state.Metadata.IsNull() && !state.Metadata.IsUnknown() {
-			oldMetadata := make(map[string]string)
-			resp.Diagnostics.Append(state.Metadata.ElementsAs(ctx, &oldMetadata, false)...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			for key := range oldMetadata {
-				if _, exists := updateMap[key]; !exists {
-					updateMap[key] = nil
-				}
-			}
+	if !plan.MetadataAll.Equal(state.MetadataAll) && !plan.MetadataAll.IsUnknown() {
+		metadata, diags := metadataUpdate(ctx, plan.MetadataAll, state.MetadataAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		updateReq.Metadata = updateMap
+		updateReq.Metadata = metadata
 	}
 
 	user, err := r.client.UpdateUser(ctx, state.ID.ValueString(), updateReq)
@@ -508,6 +798,21 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	plan.EmailVerificationPending = state.EmailVerificationPending
+	if emailChanged {
+		plan.EmailVerificationPending = types.BoolValue(false)
+	}
+	if verifyEmail && !user.EmailVerified {
+		if _, err := r.client.SendVerificationEmail(ctx, user.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Sending Verification Email",
+				"The email address of user "+user.ID+" was changed to "+user.Email+" but the verification email could not be sent: "+err.Error(),
+			)
+			return
+		}
+		plan.EmailVerificationPending = types.BoolValue(true)
+	}
+
 	// Map response to state
 	plan.ID = state.ID
 	plan.Email = types.StringValue(user.Email)
@@ -528,13 +833,12 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	} else {
 		plan.ExternalID = types.StringNull()
 	}
-	if len(user.Metadata) > 0 {
-		metadataMap, diags := types.MapValueFrom(ctx, types.StringType, user.Metadata)
-		resp.Diagnostics.Append(diags...)
-		plan.Metadata = metadataMap
-	} else {
-		plan.Metadata = types.MapNull(types.StringType)
-	}
+	metadata, diags := configuredMetadata(ctx, defaultMetadata(r.client), user.Metadata, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	plan.Metadata = metadata
+	metadataAll, diags := metadataValue(ctx, user.Metadata)
+	resp.Diagnostics.Append(diags...)
+	plan.MetadataAll = metadataAll
 	if user.Locale != "" {
 		plan.Locale = types.StringValue(user.Locale)
 	} else {
@@ -551,6 +855,8 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
 	var state UserResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -562,6 +868,23 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		"id": state.ID.ValueString(),
 	})
 
+	if state.DeletionBehavior.ValueString() == userDeletionBehaviorAnonymize {
+		if err := r.anonymizeUser(ctx, state.ID.ValueString()); err != nil {
+			if client.IsNotFound(err) {
+				tflog.Info(ctx, "User already deleted", map[string]any{
+					"id": state.ID.ValueString(),
+				})
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Anonymizing User",
+				"Could not anonymize user before deletion, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	err := r.client.DeleteUser(ctx, state.ID.ValueString())
 	if err != nil {
 		if client.IsNotFound(err) {
@@ -583,6 +906,51 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	})
 }
 
+// anonymizeUser clears the user's names and every metadata key currently set
+// in WorkOS, which may include keys not managed by this configuration.
+func (r *UserResource) anonymizeUser(ctx context.Context, id string) error {
+	user, err := r.client.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	anonymizeReq := &client.UserAnonymizeRequest{}
+	if len(user.Metadata) > 0 {
+		anonymizeReq.Metadata = make(map[string]*string, len(user.Metadata))
+		for key := range user.Metadata {
+			anonymizeReq.Metadata[key] = nil
+		}
+	}
+
+	if _, err := r.client.AnonymizeUser(ctx, id, anonymizeReq); err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "Anonymized user before deletion", map[string]any{
+		"id": id,
+	})
+	return nil
+}
+
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() && !req.State.Raw.IsNull() {
+		// Attribute plan modifiers compare the plan before email_verified is
+		// kept by email_verified_policy, so updated_at is planned again.
+		var updatedAt types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("updated_at"), &updatedAt)...)
+		if userUpdatedAtPlanModifier.configChanged(ctx, resp.Plan, req.State, &resp.Diagnostics) {
+			updatedAt = types.StringUnknown()
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("updated_at"), updatedAt)...)
+		planUserOrganizationMembership(ctx, req, resp)
+	}
+
+	planMetadataAll(ctx, r.client, req, resp)
+}
+
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Importing user", map[string]any{
 		"id": req.ID,
@@ -597,3 +965,7 @@ func (r *UserResource) ImportState(ctx context.Context, req resource.ImportState
 			"If password authentication is required, you must set these attributes in your configuration.",
 	)
 }
+
+func (r *UserResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
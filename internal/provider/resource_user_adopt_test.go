@@ -0,0 +1,247 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+const userAdoptExistingFixture = `{
+  "id": "user_01JYQ5B9Q6ZP8K4R2T1V0X9ABC",
+  "object": "user",
+  "email": "jane@example.com",
+  "email_verified": true,
+  "metadata": {"keep": "old", "stale": "value"},
+  "created_at": "2026-01-15T12:00:00.000Z",
+  "updated_at": "2026-01-15T12:00:00.000Z"
+}`
+
+// userAdoptServer rejects user creation with the configured status and body,
+// and serves the lookup and update calls made when adopting the user.
+type userAdoptServer struct {
+	t            *testing.T
+	createStatus int
+	createBody   string
+	requests     []string
+	updateBody   map[string]any
+}
+
+func (s *userAdoptServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.Method + " " + r.URL.Path {
+	case "POST /user_management/users":
+		w.WriteHeader(s.createStatus)
+		_, _ = w.Write([]byte(s.createBody))
+	case "GET /user_management/users":
+		if got := r.URL.Query().Get("email"); got != "jane@example.com" {
+			s.t.Fatalf("expected email=jane@example.com, got %q", got)
+		}
+		_, _ = w.Write([]byte(`{"data":[` + userAdoptExistingFixture + `],"list_metadata":{}}`))
+	case "PUT /user_management/users/user_01JYQ5B9Q6ZP8K4R2T1V0X9ABC":
+		if err := json.NewDecoder(r.Body).Decode(&s.updateBody); err != nil {
+			s.t.Fatalf("failed to decode request body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{
+  "id": "user_01JYQ5B9Q6ZP8K4R2T1V0X9ABC",
+  "email": "jane@example.com",
+  "email_verified": true,
+  "first_name": "Jane",
+  "metadata": {"keep": "new"},
+  "created_at": "2026-01-15T12:00:00.000Z",
+  "updated_at": "2026-01-16T12:00:00.000Z"
+}`))
+	default:
+		s.t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func createUserForAdoptTest(t *testing.T, serverURL string, adoptExisting bool, password tftypes.Value) (*resource.CreateResponse, UserResourceModel) {
+	t.Helper()
+
+	c, err := client.NewClient("sk_test", "", serverURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &UserResource{client: c}
+
+	plan := testResourceState(t, r, map[string]tftypes.Value{
+		"email":          tftypes.NewValue(tftypes.String, "jane@example.com"),
+		"email_verified": tftypes.NewValue(tftypes.Bool, true),
+		"first_name":     tftypes.NewValue(tftypes.String, "Jane"),
+		"password":       password,
+		"adopt_existing": tftypes.NewValue(tftypes.Bool, adoptExisting),
+		"metadata": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"keep": tftypes.NewValue(tftypes.String, "new"),
+		}),
+		"metadata_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"keep": tftypes.NewValue(tftypes.String, "new"),
+		}),
+	})
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: plan.Schema,
+			Raw:    tftypes.NewValue(plan.Raw.Type(), nil),
+		},
+	}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	var state UserResourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	}
+	return resp, state
+}
+
+func TestUserResourceCreateAdoptsExistingUserOnConflict(t *testing.T) {
+	fake := &userAdoptServer{
+		t:            t,
+		createStatus: http.StatusConflict,
+		createBody:   `{"message":"User already exists"}`,
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	resp, state := createUserForAdoptTest(t, server.URL, true, tftypes.NewValue(tftypes.String, nil))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	expected := []string{
+		"POST /user_management/users",
+		"GET /user_management/users",
+		"PUT /user_management/users/user_01JYQ5B9Q6ZP8K4R2T1V0X9ABC",
+	}
+	if fmt.Sprint(fake.requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, fake.requests)
+	}
+
+	metadata, ok := fake.updateBody["metadata"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected metadata in update body, got %#v", fake.updateBody)
+	}
+	if metadata["keep"] != "new" {
+		t.Fatalf("expected keep=new, got %#v", metadata["keep"])
+	}
+	if value, exists := metadata["stale"]; !exists || value != nil {
+		t.Fatalf("expected stale metadata key to be sent as null, got %#v", metadata)
+	}
+
+	if state.ID.ValueString() != "user_01JYQ5B9Q6ZP8K4R2T1V0X9ABC" {
+		t.Fatalf("unexpected ID: %s", state.ID.ValueString())
+	}
+	if !state.AdoptExisting.ValueBool() {
+		t.Fatal("expected adopt_existing to remain true in state")
+	}
+}
+
+func TestUserResourceCreateAdoptsExistingUserOnEmailNotAvailable(t *testing.T) {
+	fake := &userAdoptServer{
+		t:            t,
+		createStatus: http.StatusUnprocessableEntity,
+		createBody:   `{"code":"user_creation_error","message":"Could not create user.","errors":[{"code":"email_not_available","message":"This email is not available."}]}`,
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	resp, state := createUserForAdoptTest(t, server.URL, true, tftypes.NewValue(tftypes.String, nil))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if state.ID.ValueString() != "user_01JYQ5B9Q6ZP8K4R2T1V0X9ABC" {
+		t.Fatalf("unexpected ID: %s", state.ID.ValueString())
+	}
+}
+
+func TestUserResourceCreateDoesNotAdoptWhenDisabled(t *testing.T) {
+	fake := &userAdoptServer{
+		t:            t,
+		createStatus: http.StatusConflict,
+		createBody:   `{"message":"User already exists"}`,
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	resp, _ := createUserForAdoptTest(t, server.URL, false, tftypes.NewValue(tftypes.String, nil))
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected create error when adopt_existing is false")
+	}
+	if len(fake.requests) != 1 {
+		t.Fatalf("expected only the create request, got %v", fake.requests)
+	}
+}
+
+func TestUserResourceCreateRejectsAdoptionWithPassword(t *testing.T) {
+	fake := &userAdoptServer{
+		t:            t,
+		createStatus: http.StatusConflict,
+		createBody:   `{"message":"User already exists"}`,
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	resp, _ := createUserForAdoptTest(t, server.URL, true, tftypes.NewValue(tftypes.String, "hunter22"))
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected create error when adopting a user with a configured password")
+	}
+	if len(fake.requests) != 1 {
+		t.Fatalf("expected only the create request, got %v", fake.requests)
+	}
+}
+
+func TestIsUserEmailConflict(t *testing.T) {
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"conflict status": {
+			err:      &client.APIError{StatusCode: http.StatusConflict},
+			expected: true,
+		},
+		"email_not_available code": {
+			err:      &client.APIError{StatusCode: http.StatusUnprocessableEntity, Code: "email_not_available"},
+			expected: true,
+		},
+		"nested email_not_available": {
+			err: fmt.Errorf("failed to create user: %w", &client.APIError{
+				StatusCode: http.StatusUnprocessableEntity,
+				Errors:     []client.ValidationError{{Code: "email_not_available"}},
+			}),
+			expected: true,
+		},
+		"other validation error": {
+			err: &client.APIError{
+				StatusCode: http.StatusUnprocessableEntity,
+				Errors:     []client.ValidationError{{Field: "email", Code: "invalid"}},
+			},
+			expected: false,
+		},
+		"non api error": {
+			err:      fmt.Errorf("connection refused"),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := isUserEmailConflict(tc.err); got != tc.expected {
+				t.Fatalf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
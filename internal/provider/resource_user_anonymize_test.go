@@ -0,0 +1,107 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// userDeleteServer serves a user with metadata and records the requests made
+// while deleting it.
+type userDeleteServer struct {
+	t          *testing.T
+	requests   []string
+	updateBody map[string]any
+}
+
+func (s *userDeleteServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.Method + " " + r.URL.Path {
+	case "GET /user_management/users/user_123":
+		_, _ = w.Write([]byte(`{"id":"user_123","email":"jane@example.com","first_name":"Jane","last_name":"Doe","metadata":{"department":"Engineering","unmanaged":"value"}}`))
+	case "PUT /user_management/users/user_123":
+		if err := json.NewDecoder(r.Body).Decode(&s.updateBody); err != nil {
+			s.t.Fatalf("failed to decode request body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"id":"user_123","email":"jane@example.com"}`))
+	case "DELETE /user_management/users/user_123":
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		s.t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func deleteUserForTest(t *testing.T, fake *userDeleteServer, deletionBehavior string) *resource.DeleteResponse {
+	t.Helper()
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &UserResource{client: c}
+
+	resp := &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{
+		State: testResourceState(t, r, map[string]tftypes.Value{
+			"id":                tftypes.NewValue(tftypes.String, "user_123"),
+			"email":             tftypes.NewValue(tftypes.String, "jane@example.com"),
+			"deletion_behavior": tftypes.NewValue(tftypes.String, deletionBehavior),
+		}),
+	}, resp)
+	return resp
+}
+
+func TestUserResourceDeleteAnonymizesFirst(t *testing.T) {
+	fake := &userDeleteServer{t: t}
+	resp := deleteUserForTest(t, fake, userDeletionBehaviorAnonymize)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	expected := []string{
+		"GET /user_management/users/user_123",
+		"PUT /user_management/users/user_123",
+		"DELETE /user_management/users/user_123",
+	}
+	if fmt.Sprint(fake.requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, fake.requests)
+	}
+	if fake.updateBody["first_name"] != "" || fake.updateBody["last_name"] != "" {
+		t.Fatalf("expected names to be cleared, got %#v", fake.updateBody)
+	}
+	metadata, ok := fake.updateBody["metadata"].(map[string]any)
+	if !ok || len(metadata) != 2 {
+		t.Fatalf("expected every metadata key to be cleared, got %#v", fake.updateBody)
+	}
+	for key, value := range metadata {
+		if value != nil {
+			t.Fatalf("expected metadata key %s to be sent as null, got %#v", key, value)
+		}
+	}
+}
+
+func TestUserResourceDeleteByDefault(t *testing.T) {
+	fake := &userDeleteServer{t: t}
+	resp := deleteUserForTest(t, fake, userDeletionBehaviorDelete)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(fake.requests) != 1 || fake.requests[0] != "DELETE /user_management/users/user_123" {
+		t.Fatalf("expected only the delete request, got %v", fake.requests)
+	}
+}
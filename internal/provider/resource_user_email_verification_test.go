@@ -0,0 +1,246 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func userEmailVerificationValues(email string, emailVerified, verifyEmailChange bool) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"id":                         tftypes.NewValue(tftypes.String, "user_123"),
+		"email":                      tftypes.NewValue(tftypes.String, email),
+		"email_verified":             tftypes.NewValue(tftypes.Bool, emailVerified),
+		"verify_email_change":        tftypes.NewValue(tftypes.Bool, verifyEmailChange),
+		"email_verification_pending": tftypes.NewValue(tftypes.Bool, false),
+		"adopt_existing":             tftypes.NewValue(tftypes.Bool, false),
+		"created_at":                 tftypes.NewValue(tftypes.String, "2026-01-15T12:00:00Z"),
+	}
+}
+
+func updateUserEmailForTest(t *testing.T, serverURL string, verifyEmailChange bool) (*resource.UpdateResponse, UserResourceModel) {
+	t.Helper()
+
+	c, err := client.NewClient("sk_test", "", serverURL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &UserResource{client: c}
+
+	state := testResourceState(t, r, userEmailVerificationValues("old@example.com", true, verifyEmailChange))
+	planValues := userEmailVerificationValues("new@example.com", !verifyEmailChange, verifyEmailChange)
+	planValues["email_verification_pending"] = tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue)
+	plan := testResourceState(t, r, planValues)
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State: state,
+	}, resp)
+
+	var result UserResourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	}
+	return resp, result
+}
+
+func TestUserResourceUpdateVerifiesChangedEmail(t *testing.T) {
+	var requests []string
+	var updateBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /user_management/users/user_123":
+			if err := json.NewDecoder(r.Body).Decode(&updateBody); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"id":"user_123","email":"new@example.com","email_verified":false,"updated_at":"2026-01-16T12:00:00.000Z"}`))
+		case "POST /user_management/users/user_123/email_verification/send":
+			_, _ = w.Write([]byte(`{"user":{"id":"user_123","email":"new@example.com","email_verified":false}}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	resp, state := updateUserEmailForTest(t, server.URL, true)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	expected := []string{
+		"PUT /user_management/users/user_123",
+		"POST /user_management/users/user_123/email_verification/send",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	if updateBody["email"] != "new@example.com" || updateBody["email_verified"] != false {
+		t.Fatalf("expected the new email to be sent unverified, got %#v", updateBody)
+	}
+	if !state.EmailVerificationPending.ValueBool() {
+		t.Fatal("expected email_verification_pending to be true")
+	}
+}
+
+func TestUserResourceUpdateOverwritesEmailByDefault(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"user_123","email":"new@example.com","email_verified":false,"updated_at":"2026-01-16T12:00:00.000Z"}`))
+	}))
+	defer server.Close()
+
+	resp, state := updateUserEmailForTest(t, server.URL, false)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("expected only the update request, got %v", requests)
+	}
+	if state.EmailVerificationPending.ValueBool() {
+		t.Fatal("expected email_verification_pending to be false")
+	}
+}
+
+func TestEmailVerifiedPlanModifier(t *testing.T) {
+	testCases := map[string]struct {
+		verifyEmailChange bool
+		planEmail         string
+		expected          types.Bool
+	}{
+		"disabled":        {verifyEmailChange: false, planEmail: "new@example.com", expected: types.BoolValue(false)},
+		"email unchanged": {verifyEmailChange: true, planEmail: "old@example.com", expected: types.BoolValue(true)},
+		"email changed":   {verifyEmailChange: true, planEmail: "new@example.com", expected: types.BoolValue(false)},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := &UserResource{}
+			state := testResourceState(t, r, userEmailVerificationValues("old@example.com", true, tc.verifyEmailChange))
+			plan := testResourceState(t, r, userEmailVerificationValues(tc.planEmail, false, tc.verifyEmailChange))
+
+			// The schema default has already planned false for the unset attribute.
+			resp := &planmodifier.BoolResponse{PlanValue: types.BoolValue(false)}
+			emailVerifiedPlanModifier{}.PlanModifyBool(context.Background(), planmodifier.BoolRequest{
+				ConfigValue: types.BoolNull(),
+				PlanValue:   types.BoolValue(false),
+				StateValue:  types.BoolValue(true),
+				Plan:        tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				State:       state,
+			}, resp)
+
+			if !resp.PlanValue.Equal(tc.expected) {
+				t.Fatalf("expected %s, got %s", tc.expected, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestEmailVerifiedPlanModifierPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		policy     string
+		configured bool
+		state      bool
+		expected   types.Bool
+	}{
+		"enforce unverifies":          {policy: userEmailVerifiedPolicyEnforce, configured: false, state: true, expected: types.BoolValue(false)},
+		"ignore_upgrades keeps":       {policy: userEmailVerifiedPolicyIgnoreUpgrades, configured: false, state: true, expected: types.BoolValue(true)},
+		"ignore_upgrades verifies":    {policy: userEmailVerifiedPolicyIgnoreUpgrades, configured: true, state: false, expected: types.BoolValue(true)},
+		"ignore keeps verified":       {policy: userEmailVerifiedPolicyIgnore, configured: false, state: true, expected: types.BoolValue(true)},
+		"ignore keeps unverified":     {policy: userEmailVerifiedPolicyIgnore, configured: true, state: false, expected: types.BoolValue(false)},
+		"enforce verifies unverified": {policy: userEmailVerifiedPolicyEnforce, configured: true, state: false, expected: types.BoolValue(true)},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := &UserResource{}
+			state := testResourceState(t, r, userEmailVerificationValues("jane@example.com", tc.state, false))
+			planValues := userEmailVerificationValues("jane@example.com", tc.configured, false)
+			planValues["email_verified_policy"] = tftypes.NewValue(tftypes.String, tc.policy)
+			plan := testResourceState(t, r, planValues)
+
+			resp := &planmodifier.BoolResponse{PlanValue: types.BoolValue(tc.configured)}
+			emailVerifiedPlanModifier{}.PlanModifyBool(context.Background(), planmodifier.BoolRequest{
+				ConfigValue: types.BoolValue(tc.configured),
+				PlanValue:   types.BoolValue(tc.configured),
+				StateValue:  types.BoolValue(tc.state),
+				Plan:        tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				State:       state,
+			}, resp)
+
+			if !resp.PlanValue.Equal(tc.expected) {
+				t.Fatalf("expected %s, got %s", tc.expected, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestUserResourceModifyPlanKeepsUpdatedAtForIgnoredVerification(t *testing.T) {
+	ctx := context.Background()
+	r := &UserResource{}
+
+	stateValues := userEmailVerificationValues("jane@example.com", true, false)
+	stateValues["updated_at"] = tftypes.NewValue(tftypes.String, "2026-01-16T12:00:00Z")
+	stateValues["email_verified_policy"] = tftypes.NewValue(tftypes.String, userEmailVerifiedPolicyIgnoreUpgrades)
+	state := testResourceState(t, r, stateValues)
+
+	// email_verified has been kept by its plan modifier, but updated_at was
+	// planned unknown from the configured false.
+	planValues := userEmailVerificationValues("jane@example.com", true, false)
+	planValues["updated_at"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	planValues["email_verified_policy"] = tftypes.NewValue(tftypes.String, userEmailVerifiedPolicyIgnoreUpgrades)
+	plan := testResourceState(t, r, planValues)
+
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State:  state,
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var updatedAt types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("updated_at"), &updatedAt)...)
+	if updatedAt.ValueString() != "2026-01-16T12:00:00Z" {
+		t.Fatalf("expected updated_at to be kept, got %s", updatedAt)
+	}
+}
+
+func TestUserResourceValidateConfigRejectsEmailVerifiedWithVerification(t *testing.T) {
+	r := &UserResource{}
+	config := testResourceState(t, r, map[string]tftypes.Value{
+		"email":               tftypes.NewValue(tftypes.String, "jane@example.com"),
+		"email_verified":      tftypes.NewValue(tftypes.Bool, true),
+		"verify_email_change": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when email_verified is set alongside verify_email_change")
+	}
+}
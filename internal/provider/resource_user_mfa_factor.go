@@ -0,0 +1,267 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+var _ resource.Resource = &UserMFAFactorResource{}
+var _ resource.ResourceWithImportState = &UserMFAFactorResource{}
+var _ resource.ResourceWithUpgradeState = &UserMFAFactorResource{}
+
+func NewUserMFAFactorResource() resource.Resource {
+	return &UserMFAFactorResource{}
+}
+
+type UserMFAFactorResource struct {
+	client *client.Client
+}
+
+type UserMFAFactorResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	UserID     types.String `tfsdk:"user_id"`
+	Type       types.String `tfsdk:"type"`
+	TOTPIssuer types.String `tfsdk:"totp_issuer"`
+	TOTPUser   types.String `tfsdk:"totp_user"`
+	TOTPSecret types.String `tfsdk:"totp_secret"`
+	TOTPQRCode types.String `tfsdk:"totp_qr_code"`
+	TOTPURI    types.String `tfsdk:"totp_uri"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+func (r *UserMFAFactorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_mfa_factor"
+}
+
+func (r *UserMFAFactorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     userMFAFactorSchemaVersion,
+		Description: "Enrolls a TOTP multi-factor authentication factor for a WorkOS user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the authentication factor.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user to enroll the factor for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The factor type. Always totp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"totp_issuer": schema.StringAttribute{
+				Description: "The issuer shown in the authenticator app, typically your application or company name.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"totp_user": schema.StringAttribute{
+				Description: "The account name shown in the authenticator app, typically the user's email address.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"totp_secret": schema.StringAttribute{
+				Description: "The TOTP secret. Only returned at enrollment and not available after import.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"totp_qr_code": schema.StringAttribute{
+				Description: "A base64-encoded data URI of a QR code for the TOTP secret. Only returned at enrollment and not available after import.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"totp_uri": schema.StringAttribute{
+				Description: "The otpauth URI for the TOTP secret. Only returned at enrollment and not available after import.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the factor was enrolled.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the factor was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *UserMFAFactorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *UserMFAFactorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var plan UserMFAFactorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enrollReq := &client.AuthenticationFactorEnrollRequest{Type: "totp"}
+	if !plan.TOTPIssuer.IsNull() && !plan.TOTPIssuer.IsUnknown() {
+		enrollReq.TOTPIssuer = plan.TOTPIssuer.ValueString()
+	}
+	if !plan.TOTPUser.IsNull() && !plan.TOTPUser.IsUnknown() {
+		enrollReq.TOTPUser = plan.TOTPUser.ValueString()
+	}
+
+	factor, err := r.client.EnrollAuthenticationFactor(ctx, plan.UserID.ValueString(), enrollReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Enrolling MFA Factor", "Could not enroll authentication factor: "+err.Error())
+		return
+	}
+
+	// The secret, QR code and URI are only returned here, so they are captured
+	// once and carried forward in state from then on.
+	plan.TOTPSecret = types.StringNull()
+	plan.TOTPQRCode = types.StringNull()
+	plan.TOTPURI = types.StringNull()
+	if factor.TOTP != nil {
+		plan.TOTPSecret = optionalString(&factor.TOTP.Secret)
+		plan.TOTPQRCode = optionalString(&factor.TOTP.QRCode)
+		plan.TOTPURI = optionalString(&factor.TOTP.URI)
+	}
+
+	userMFAFactorToState(&plan, factor)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserMFAFactorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var state UserMFAFactorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	factor, err := r.client.GetAuthenticationFactor(ctx, state.ID.ValueString())
+	if err != nil {
+		if isGoneOnRead(r.client, err, &resp.Diagnostics) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading MFA Factor", "Could not read authentication factor: "+err.Error())
+		return
+	}
+
+	userMFAFactorToState(&state, factor)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *UserMFAFactorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan UserMFAFactorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserMFAFactorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer warnMaintenanceDelay(r.client, &resp.Diagnostics)
+
+	var state UserMFAFactorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAuthenticationFactor(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Info(ctx, "Authentication factor already deleted", map[string]any{"id": state.ID.ValueString()})
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting MFA Factor", "Could not delete authentication factor: "+err.Error())
+	}
+}
+
+func (r *UserMFAFactorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	resp.Diagnostics.AddWarning(
+		"TOTP Secret Not Imported",
+		"The TOTP secret, QR code and URI are only returned when a factor is enrolled and cannot be imported from the API.",
+	)
+}
+
+// userMFAFactorToState copies the API fields that are returned on every read.
+// The TOTP secret, QR code and URI are left untouched.
+func userMFAFactorToState(state *UserMFAFactorResourceModel, factor *client.AuthenticationFactor) {
+	state.ID = types.StringValue(factor.ID)
+	state.Type = types.StringValue(factor.Type)
+	if factor.UserID != "" {
+		state.UserID = types.StringValue(factor.UserID)
+	}
+	if factor.TOTP != nil {
+		state.TOTPIssuer = optionalString(&factor.TOTP.Issuer)
+		state.TOTPUser = optionalString(&factor.TOTP.User)
+	}
+	state.CreatedAt = types.StringValue(factor.CreatedAt.Format(time.RFC3339))
+	state.UpdatedAt = types.StringValue(factor.UpdatedAt.Format(time.RFC3339))
+}
+
+func (r *UserMFAFactorResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
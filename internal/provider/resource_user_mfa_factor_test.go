@@ -0,0 +1,100 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+func newUserMFAFactorTestResource(t *testing.T, handler http.HandlerFunc) *UserMFAFactorResource {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return &UserMFAFactorResource{client: c}
+}
+
+func TestUserMFAFactorResourceCreateCapturesSecret(t *testing.T) {
+	r := newUserMFAFactorTestResource(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "POST /user_management/users/user_123/auth_factors" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"authentication_factor":{"id":"auth_factor_123","type":"totp","user_id":"user_123","totp":{"issuer":"Acme","user":"jane@example.com","secret":"JJWBYBLLH5TUIMT2","qr_code":"data:image/png;base64,iVBORw0KGgo=","uri":"otpauth://totp/Acme"},"created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-15T12:00:00.000Z"}}`))
+	})
+
+	plan := testResourceState(t, r, map[string]tftypes.Value{
+		"id":           tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"user_id":      tftypes.NewValue(tftypes.String, "user_123"),
+		"totp_issuer":  tftypes.NewValue(tftypes.String, "Acme"),
+		"totp_user":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"totp_secret":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"totp_qr_code": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"totp_uri":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+	}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state UserMFAFactorResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.ID.ValueString() != "auth_factor_123" || state.Type.ValueString() != "totp" {
+		t.Fatalf("unexpected state: %#v", state)
+	}
+	if state.TOTPSecret.ValueString() != "JJWBYBLLH5TUIMT2" || state.TOTPURI.ValueString() != "otpauth://totp/Acme" {
+		t.Fatalf("expected TOTP secret and URI in state, got %s / %s", state.TOTPSecret, state.TOTPURI)
+	}
+	if state.TOTPUser.ValueString() != "jane@example.com" {
+		t.Fatalf("expected totp_user from the API, got %s", state.TOTPUser)
+	}
+}
+
+func TestUserMFAFactorResourceReadKeepsSecret(t *testing.T) {
+	r := newUserMFAFactorTestResource(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "GET /auth/factors/auth_factor_123" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"auth_factor_123","type":"totp","user_id":"user_123","totp":{"issuer":"Acme","user":"jane@example.com"},"created_at":"2026-01-15T12:00:00.000Z","updated_at":"2026-01-16T12:00:00.000Z"}`))
+	})
+
+	current := testResourceState(t, r, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "auth_factor_123"),
+		"user_id":     tftypes.NewValue(tftypes.String, "user_123"),
+		"totp_secret": tftypes.NewValue(tftypes.String, "JJWBYBLLH5TUIMT2"),
+	})
+	resp := &resource.ReadResponse{State: current}
+	r.Read(context.Background(), resource.ReadRequest{State: current}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state UserMFAFactorResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.TOTPSecret.ValueString() != "JJWBYBLLH5TUIMT2" {
+		t.Fatalf("expected secret to be kept from prior state, got %s", state.TOTPSecret)
+	}
+	if state.UpdatedAt.ValueString() != "2026-01-16T12:00:00Z" {
+		t.Fatalf("unexpected updated_at: %s", state.UpdatedAt.ValueString())
+	}
+}
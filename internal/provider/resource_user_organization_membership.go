@@ -0,0 +1,198 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+// UserOrganizationMembershipModel describes the organization_membership block
+// of a workos_user: a membership the user is created into and which is managed
+// alongside it.
+type UserOrganizationMembershipModel struct {
+	ID             types.String `tfsdk:"id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	RoleSlug       types.String `tfsdk:"role_slug"`
+}
+
+func userOrganizationMembershipBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "An organization to add the user to as soon as it is created. Changing the organization moves the membership, " +
+			"and removing the block deletes it. Do not also manage the same membership with workos_organization_membership.",
+		MarkdownDescription: "An organization to add the user to as soon as it is created. Changing the organization moves the membership, " +
+			"and removing the block deletes it. Do not also manage the same membership with `workos_organization_membership`.",
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The unique identifier of the organization membership.",
+					Computed:    true,
+				},
+				"organization_id": schema.StringAttribute{
+					Description: "The ID of the organization to add the user to.",
+					Required:    true,
+				},
+				"role_slug": schema.StringAttribute{
+					Description:         "The slug of the role to assign to the user within the organization. Defaults to the organization's default role.",
+					MarkdownDescription: "The slug of the role to assign to the user within the organization (e.g., `admin`, `member`). Defaults to the organization's default role.",
+					Optional:            true,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// createUserOrganizationMembership adds the user to the organization of a
+// planned organization_membership block and records the result in it.
+func (r *UserResource) createUserOrganizationMembership(ctx context.Context, userID string, membership *UserOrganizationMembershipModel) error {
+	createReq := &client.OrganizationMembershipCreateRequest{
+		UserID:         userID,
+		OrganizationID: membership.OrganizationID.ValueString(),
+	}
+	if !membership.RoleSlug.IsNull() && !membership.RoleSlug.IsUnknown() {
+		createReq.RoleSlug = membership.RoleSlug.ValueString()
+	}
+
+	created, err := r.client.CreateOrganizationMembership(ctx, createReq)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "Created user organization membership", map[string]any{
+		"id":              created.ID,
+		"user_id":         userID,
+		"organization_id": created.OrganizationID,
+	})
+
+	userOrganizationMembershipToState(membership, created)
+	return nil
+}
+
+// readUserOrganizationMembership refreshes the organization_membership block
+// of a user. A membership that no longer exists is dropped, so it is planned
+// to be created again.
+func (r *UserResource) readUserOrganizationMembership(ctx context.Context, memberships []UserOrganizationMembershipModel, diags *diag.Diagnostics) []UserOrganizationMembershipModel {
+	if len(memberships) == 0 || memberships[0].ID.IsNull() {
+		return memberships
+	}
+
+	membership, err := r.client.GetOrganizationMembership(ctx, memberships[0].ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Info(ctx, "User organization membership not found, removing from state", map[string]any{
+				"id": memberships[0].ID.ValueString(),
+			})
+			return nil
+		}
+		diags.AddAttributeError(
+			path.Root("organization_membership"),
+			"Error Reading User Organization Membership",
+			"Could not read organization membership "+memberships[0].ID.ValueString()+": "+err.Error(),
+		)
+		return memberships
+	}
+
+	userOrganizationMembershipToState(&memberships[0], membership)
+	return memberships
+}
+
+// updateUserOrganizationMembership applies a change to the
+// organization_membership block of a user. Moving the membership to another
+// organization deletes the old one before creating the new one.
+func (r *UserResource) updateUserOrganizationMembership(ctx context.Context, userID string, plan, state []UserOrganizationMembershipModel, diags *diag.Diagnostics) {
+	var current *UserOrganizationMembershipModel
+	if len(state) > 0 {
+		current = &state[0]
+	}
+	var planned *UserOrganizationMembershipModel
+	if len(plan) > 0 {
+		planned = &plan[0]
+	}
+
+	if current != nil && planned != nil && current.OrganizationID.Equal(planned.OrganizationID) {
+		planned.ID = current.ID
+		if planned.RoleSlug.IsUnknown() || planned.RoleSlug.Equal(current.RoleSlug) {
+			planned.RoleSlug = current.RoleSlug
+			return
+		}
+
+		updated, err := r.client.UpdateOrganizationMembership(ctx, current.ID.ValueString(), &client.OrganizationMembershipUpdateRequest{
+			RoleSlug: planned.RoleSlug.ValueString(),
+		})
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("organization_membership"),
+				"Error Updating User Organization Membership",
+				"Could not update organization membership "+current.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		userOrganizationMembershipToState(planned, updated)
+		return
+	}
+
+	if current != nil {
+		err := r.client.DeleteOrganizationMembership(ctx, current.ID.ValueString())
+		if err != nil && !client.IsNotFound(err) {
+			diags.AddAttributeError(
+				path.Root("organization_membership"),
+				"Error Deleting User Organization Membership",
+				"Could not delete organization membership "+current.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if planned != nil {
+		if err := r.createUserOrganizationMembership(ctx, userID, planned); err != nil {
+			diags.AddAttributeError(
+				path.Root("organization_membership"),
+				"Error Creating User Organization Membership",
+				fmt.Sprintf("Could not add user %s to organization %s: %s", userID, planned.OrganizationID.ValueString(), err),
+			)
+		}
+	}
+}
+
+// planUserOrganizationMembership keeps the computed attributes of an
+// organization_membership block from state while it stays in the same
+// organization, so they are only shown as changing when the membership is
+// moved.
+func planUserOrganizationMembership(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state []UserOrganizationMembershipModel
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("organization_membership"), &plan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("organization_membership"), &state)...)
+	if resp.Diagnostics.HasError() || len(plan) == 0 || len(state) == 0 {
+		return
+	}
+	if !plan[0].OrganizationID.Equal(state[0].OrganizationID) {
+		return
+	}
+
+	plan[0].ID = state[0].ID
+	if plan[0].RoleSlug.IsUnknown() {
+		plan[0].RoleSlug = state[0].RoleSlug
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("organization_membership"), plan)...)
+}
+
+func userOrganizationMembershipToState(state *UserOrganizationMembershipModel, membership *client.OrganizationMembership) {
+	state.ID = types.StringValue(membership.ID)
+	state.OrganizationID = types.StringValue(membership.OrganizationID)
+	state.RoleSlug = optionalString(&membership.Role.Slug)
+}
@@ -0,0 +1,259 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/osodevops/terraform-provider-workos/internal/client"
+)
+
+var userOrganizationMembershipType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"id":              tftypes.String,
+	"organization_id": tftypes.String,
+	"role_slug":       tftypes.String,
+}}
+
+func userOrganizationMembershipValue(id, organizationID, roleSlug any) tftypes.Value {
+	if organizationID == nil {
+		return tftypes.NewValue(tftypes.List{ElementType: userOrganizationMembershipType}, []tftypes.Value{})
+	}
+	return tftypes.NewValue(tftypes.List{ElementType: userOrganizationMembershipType}, []tftypes.Value{
+		tftypes.NewValue(userOrganizationMembershipType, map[string]tftypes.Value{
+			"id":              tftypes.NewValue(tftypes.String, id),
+			"organization_id": tftypes.NewValue(tftypes.String, organizationID),
+			"role_slug":       tftypes.NewValue(tftypes.String, roleSlug),
+		}),
+	})
+}
+
+// userOrganizationMembershipServer serves users and organization memberships
+// and records the requests made to it.
+func userOrganizationMembershipServer(t *testing.T, requests *[]string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		request := r.Method + " " + r.URL.Path
+		if body["organization_id"] != nil || body["role_slug"] != nil {
+			request += fmt.Sprintf(" %v %v", body["organization_id"], body["role_slug"])
+		}
+		*requests = append(*requests, request)
+		w.Header().Set("Content-Type", "application/json")
+
+		role := body["role_slug"]
+		if role == nil {
+			role = "member"
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "POST /user_management/users":
+			_, _ = w.Write([]byte(`{"id":"user_123","email":"jane@example.com"}`))
+		case "POST /user_management/organization_memberships":
+			if body["organization_id"] == "org_invalid" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"message":"Organization not found"}`))
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"id":"om_new","user_id":"user_123","organization_id":%q,"role":{"slug":%q}}`, body["organization_id"], role)
+		case "PUT /user_management/organization_memberships/om_123":
+			_, _ = fmt.Fprintf(w, `{"id":"om_123","user_id":"user_123","organization_id":"org_123","role":{"slug":%q}}`, role)
+		case "DELETE /user_management/organization_memberships/om_123", "DELETE /user_management/users/user_123":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestUserResourceCreateWithOrganizationMembership(t *testing.T) {
+	var requests []string
+	server := userOrganizationMembershipServer(t, &requests)
+	defer server.Close()
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &UserResource{client: c}
+
+	plan := testResourceState(t, r, map[string]tftypes.Value{
+		"id":                      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"email":                   tftypes.NewValue(tftypes.String, "jane@example.com"),
+		"email_verified":          tftypes.NewValue(tftypes.Bool, false),
+		"adopt_existing":          tftypes.NewValue(tftypes.Bool, false),
+		"organization_membership": userOrganizationMembershipValue(tftypes.UnknownValue, "org_123", tftypes.UnknownValue),
+	})
+	resp := &resource.CreateResponse{State: plan}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	expected := []string{
+		"POST /user_management/users",
+		"POST /user_management/organization_memberships org_123 <nil>",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+
+	var result UserResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	if len(result.OrganizationMembership) != 1 {
+		t.Fatalf("expected one organization membership, got %#v", result.OrganizationMembership)
+	}
+	membership := result.OrganizationMembership[0]
+	if membership.ID.ValueString() != "om_new" || membership.RoleSlug.ValueString() != "member" {
+		t.Fatalf("expected the created membership in state, got %#v", membership)
+	}
+}
+
+func TestUserResourceCreateWithOrganizationMembershipFailure(t *testing.T) {
+	var requests []string
+	server := userOrganizationMembershipServer(t, &requests)
+	defer server.Close()
+
+	c, err := client.NewClient("sk_test", "", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	r := &UserResource{client: c}
+
+	plan := testResourceState(t, r, map[string]tftypes.Value{
+		"id":                      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"email":                   tftypes.NewValue(tftypes.String, "jane@example.com"),
+		"email_verified":          tftypes.NewValue(tftypes.Bool, false),
+		"adopt_existing":          tftypes.NewValue(tftypes.Bool, false),
+		"organization_membership": userOrganizationMembershipValue(tftypes.UnknownValue, "org_invalid", tftypes.UnknownValue),
+	})
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+	}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the membership cannot be created")
+	}
+	expected := []string{
+		"POST /user_management/users",
+		"POST /user_management/organization_memberships org_invalid <nil>",
+		"DELETE /user_management/users/user_123",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	// Nothing is saved, so the next apply creates the user again rather than
+	// replacing a tainted one.
+	if !resp.State.Raw.IsNull() {
+		t.Fatalf("expected no state to be saved, got %v", resp.State.Raw)
+	}
+}
+
+func TestUserResourceUpdateOrganizationMembership(t *testing.T) {
+	testCases := map[string]struct {
+		state          tftypes.Value
+		plan           tftypes.Value
+		expectRequests []string
+		expectID       string
+		expectRole     string
+	}{
+		"unchanged": {
+			state:      userOrganizationMembershipValue("om_123", "org_123", "admin"),
+			plan:       userOrganizationMembershipValue("om_123", "org_123", "admin"),
+			expectID:   "om_123",
+			expectRole: "admin",
+		},
+		"role changed": {
+			state:          userOrganizationMembershipValue("om_123", "org_123", "member"),
+			plan:           userOrganizationMembershipValue("om_123", "org_123", "admin"),
+			expectRequests: []string{"PUT /user_management/organization_memberships/om_123 <nil> admin"},
+			expectID:       "om_123",
+			expectRole:     "admin",
+		},
+		"organization changed": {
+			state: userOrganizationMembershipValue("om_123", "org_123", "admin"),
+			plan:  userOrganizationMembershipValue(tftypes.UnknownValue, "org_456", "admin"),
+			expectRequests: []string{
+				"DELETE /user_management/organization_memberships/om_123",
+				"POST /user_management/organization_memberships org_456 admin",
+			},
+			expectID:   "om_new",
+			expectRole: "admin",
+		},
+		"added": {
+			state:          userOrganizationMembershipValue(nil, nil, nil),
+			plan:           userOrganizationMembershipValue(tftypes.UnknownValue, "org_456", tftypes.UnknownValue),
+			expectRequests: []string{"POST /user_management/organization_memberships org_456 <nil>"},
+			expectID:       "om_new",
+			expectRole:     "member",
+		},
+		"removed": {
+			state:          userOrganizationMembershipValue("om_123", "org_123", "admin"),
+			plan:           userOrganizationMembershipValue(nil, nil, nil),
+			expectRequests: []string{"DELETE /user_management/organization_memberships/om_123"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			server := userOrganizationMembershipServer(t, &requests)
+			defer server.Close()
+
+			c, err := client.NewClient("sk_test", "", server.URL)
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			r := &UserResource{client: c}
+
+			stateValues := userEmailVerificationValues("jane@example.com", true, false)
+			stateValues["organization_membership"] = tc.state
+			state := testResourceState(t, r, stateValues)
+			planValues := userEmailVerificationValues("jane@example.com", true, false)
+			planValues["organization_membership"] = tc.plan
+			plan := testResourceState(t, r, planValues)
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(context.Background(), resource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				State: state,
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if fmt.Sprint(requests) != fmt.Sprint(tc.expectRequests) {
+				t.Fatalf("expected requests %v, got %v", tc.expectRequests, requests)
+			}
+
+			var result UserResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+			if tc.expectID == "" {
+				if len(result.OrganizationMembership) != 0 {
+					t.Fatalf("expected no organization membership, got %#v", result.OrganizationMembership)
+				}
+				return
+			}
+			if len(result.OrganizationMembership) != 1 {
+				t.Fatalf("expected one organization membership, got %#v", result.OrganizationMembership)
+			}
+			membership := result.OrganizationMembership[0]
+			if membership.ID.ValueString() != tc.expectID || membership.RoleSlug.ValueString() != tc.expectRole {
+				t.Fatalf("expected membership %s with role %s, got %#v", tc.expectID, tc.expectRole, membership)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUserResourceValidateConfigPasswordHash(t *testing.T) {
+	testCases := map[string]struct {
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		"hash with type": {
+			values: map[string]tftypes.Value{
+				"password_hash":      tftypes.NewValue(tftypes.String, "$firebase-scrypt$..."),
+				"password_hash_type": tftypes.NewValue(tftypes.String, "firebase-scrypt"),
+			},
+		},
+		"hash without type": {
+			values: map[string]tftypes.Value{
+				"password_hash": tftypes.NewValue(tftypes.String, "$2b$10$..."),
+			},
+			expectError: true,
+		},
+		"type without hash": {
+			values: map[string]tftypes.Value{
+				"password_hash_type": tftypes.NewValue(tftypes.String, "bcrypt"),
+			},
+			expectError: true,
+		},
+		"unknown hash": {
+			values: map[string]tftypes.Value{
+				"password_hash":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"password_hash_type": tftypes.NewValue(tftypes.String, "pbkdf2"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := &UserResource{}
+			tc.values["email"] = tftypes.NewValue(tftypes.String, "jane@example.com")
+			config := testResourceState(t, r, tc.values)
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%t, got %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
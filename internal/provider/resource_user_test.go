@@ -7,17 +7,17 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccUserResource_basic(t *testing.T) {
-	rName := acctest.RandomWithPrefix("tfacc")
+	rName := testAccRandomName(t)
 	resourceName := "workos_user.test"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -53,12 +53,13 @@ func TestAccUserResource_basic(t *testing.T) {
 }
 
 func TestAccUserResource_withPassword(t *testing.T) {
-	rName := acctest.RandomWithPrefix("tfacc")
+	rName := testAccRandomName(t)
 	resourceName := "workos_user.test"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccUserResourceConfig_withPassword(rName),
@@ -72,12 +73,13 @@ func TestAccUserResource_withPassword(t *testing.T) {
 }
 
 func TestAccUserResource_withExternalIDAndMetadata(t *testing.T) {
-	rName := acctest.RandomWithPrefix("tfacc")
+	rName := testAccRandomName(t)
 	resourceName := "workos_user.test"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			// Create with external_id and metadata
 			{
@@ -111,12 +113,13 @@ func TestAccUserResource_withExternalIDAndMetadata(t *testing.T) {
 }
 
 func TestAccUserResource_minimal(t *testing.T) {
-	rName := acctest.RandomWithPrefix("tfacc")
+	rName := testAccRandomName(t)
 	resourceName := "workos_user.test"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		CheckDestroy:             testAccCheckDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccUserResourceConfig_minimal(rName),
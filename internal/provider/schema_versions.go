@@ -0,0 +1,31 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Schema versions for each resource. When a schema change needs existing state
+// to be migrated (for example restructuring an attribute), bump the version
+// here and register a StateUpgrader keyed by the previous version in the
+// resource's UpgradeState.
+const (
+	authorizationResourceSchemaVersion          int64 = 0
+	authorizationRoleAssignmentSchemaVersion    int64 = 0
+	auditLogEventSchemaVersion                  int64 = 0
+	connectApplicationSchemaVersion             int64 = 0
+	connectApplicationClientSecretSchemaVersion int64 = 0
+	environmentRoleSchemaVersion                int64 = 0
+	groupSchemaVersion                          int64 = 0
+	groupMembershipSchemaVersion                int64 = 0
+	invitationSchemaVersion                     int64 = 0
+	invitationResendSchemaVersion               int64 = 0
+	organizationSchemaVersion                   int64 = 0
+	organizationDomainSchemaVersion             int64 = 0
+	organizationDomainVerificationSchemaVersion int64 = 0
+	organizationMembershipSchemaVersion         int64 = 0
+	organizationRoleSchemaVersion               int64 = 0
+	organizationRolePermissionSchemaVersion     int64 = 0
+	permissionSchemaVersion                     int64 = 0
+	sessionRevocationSchemaVersion              int64 = 0
+	userSchemaVersion                           int64 = 0
+	userMFAFactorSchemaVersion                  int64 = 0
+)
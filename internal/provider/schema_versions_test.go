@@ -0,0 +1,46 @@
+// Copyright (c) OSO DevOps
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestResourcesUpgradeEveryPriorSchemaVersion(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		metadataResp := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "workos"}, metadataResp)
+		name := metadataResp.TypeName
+
+		upgrader, ok := r.(resource.ResourceWithUpgradeState)
+		if !ok {
+			t.Errorf("%s does not implement UpgradeState", name)
+			continue
+		}
+
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+		version := schemaResp.Schema.Version
+
+		upgraders := upgrader.UpgradeState(ctx)
+		for v := int64(0); v < version; v++ {
+			if _, exists := upgraders[v]; !exists {
+				t.Errorf("%s schema version %d has no upgrader for prior version %d", name, version, v)
+			}
+		}
+		for v := range upgraders {
+			if v >= version {
+				t.Errorf("%s registers an upgrader for version %d, which is not older than schema version %d", name, v, version)
+			}
+		}
+	}
+}